@@ -121,3 +121,55 @@ func (c CTX) LoadRedisPersistCount() (int32, bool) {
 	}
 	return atomic.LoadInt32(c.RedisPersistCount), true
 }
+
+// loggerKey is the context key of the logger set by New.
+type loggerKey struct{}
+
+// countsKey is the context key of the redis counts set by New.
+type countsKey struct{}
+
+type counts struct {
+	cache   *int32
+	persist *int32
+}
+
+// New returns a CTX of parent logging with logger, the logger and the redis
+// counts are kept in the context so FromContext finds them in contexts
+// derived from the CTX.
+func New(parent context.Context, logger logrus.FieldLogger) CTX {
+	cnts := counts{
+		cache:   pointer.Int32(0),
+		persist: pointer.Int32(0),
+	}
+
+	parent = context.WithValue(parent, loggerKey{}, logger)
+	return CTX{
+		Context:           context.WithValue(parent, countsKey{}, cnts),
+		FieldLogger:       logger,
+		RedisCacheCount:   cnts.cache,
+		RedisPersistCount: cnts.persist,
+	}
+}
+
+// FromContext returns c if it is a CTX, otherwise a CTX of c logging with
+// the logger and counting with the redis counts set by New, or the standard
+// logger and no counts.
+func FromContext(c context.Context) CTX {
+	if cc, ok := c.(CTX); ok {
+		return cc
+	}
+
+	logger, ok := c.Value(loggerKey{}).(logrus.FieldLogger)
+	if !ok {
+		logger = logrus.StandardLogger()
+	}
+
+	cnts, _ := c.Value(countsKey{}).(counts)
+
+	return CTX{
+		Context:           c,
+		FieldLogger:       logger,
+		RedisCacheCount:   cnts.cache,
+		RedisPersistCount: cnts.persist,
+	}
+}
@@ -72,6 +72,23 @@ type Pool struct {
 	strategy Strategy
 }
 
+var (
+	poolsMut sync.RWMutex
+	pools    = map[*Pool]struct{}{}
+)
+
+// Pools returns the pools created and not closed yet.
+func Pools() []*Pool {
+	poolsMut.RLock()
+	defer poolsMut.RUnlock()
+
+	ps := make([]*Pool, 0, len(pools))
+	for p := range pools {
+		ps = append(ps, p)
+	}
+	return ps
+}
+
 // WithStrategy specifies strategy for scheduling
 func WithStrategy(s Strategy) PoolOption {
 	return func(o *poolOption) {
@@ -135,6 +152,10 @@ func NewPool(name string, maxWorkerSize, queueSize, initWorkerSize int, options
 	// make sure all workers needing to be initialized ready
 	wg.Wait()
 
+	poolsMut.Lock()
+	pools[p] = struct{}{}
+	poolsMut.Unlock()
+
 	// start monitor goroutine
 	if o.enableMonitor {
 		Go(func() {
@@ -219,10 +240,42 @@ func (p *Pool) GetSize() int {
 	return len(p.workers)
 }
 
+// GetName returns the name of the pool.
+func (p *Pool) GetName() string {
+	return p.name
+}
+
+// GetMaxSize returns the max size of the pool.
+func (p *Pool) GetMaxSize() int {
+	return p.maxSize
+}
+
+// GetActiveWorker returns the number of workers running a task.
+func (p *Pool) GetActiveWorker() int {
+	return p.counter.ActiveWorker()
+}
+
+// GetQueueSize returns the number of tasks waiting in queue.
+func (p *Pool) GetQueueSize() int {
+	return len(p.queueChan)
+}
+
+// GetQueueCap returns the capacity of the queue.
+func (p *Pool) GetQueueCap() int {
+	return cap(p.queueChan)
+}
+
+func (p *Pool) unregister() {
+	poolsMut.Lock()
+	delete(pools, p)
+	poolsMut.Unlock()
+}
+
 // Close will terminate all workers and close the job channel of this Pool.
 func (p *Pool) Close() {
 	// only allow calling Close() or GracefulClose() once
 	p.pauseOnce.Do(func() {
+		p.unregister()
 		p.workerMut.Lock()
 		defer p.workerMut.Unlock()
 		// Stop opening new workers
@@ -262,6 +315,7 @@ func (p *Pool) GracefulClose(options ...PoolOption) {
 	}
 	// only allow calling Close() or GracefulClose() once
 	p.pauseOnce.Do(func() {
+		p.unregister()
 		p.workerMut.Lock()
 		defer p.workerMut.Unlock()
 		// Stop opening new workers
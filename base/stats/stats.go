@@ -42,5 +42,8 @@ func Recovery(staticPath string) gin.HandlerFunc {
 				c.AbortWithStatus(500)
 			}
 		}()
+
+		// Run the handlers inside so their panic is recovered here.
+		c.Next()
 	}
 }
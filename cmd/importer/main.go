@@ -0,0 +1,120 @@
+// Command importer upserts a catalog file of banks, cards, channels, channel
+// labels and rewards through the stores.
+//
+//	go run ./cmd/importer -file catalog.yaml -dry-run
+//
+// The arguments after the flags override the config, e.g.
+//
+//	go run ./cmd/importer -file catalog.yaml -- --postgres-host=10.0.0.1
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	cache "pickrewardapi/internal/pkg/cache"
+	config "pickrewardapi/internal/pkg/config"
+	psql "pickrewardapi/internal/pkg/postgres"
+
+	bankStore "pickrewardapi/internal/domain/bank/store"
+	cardStore "pickrewardapi/internal/domain/card/store"
+	cardRewardStore "pickrewardapi/internal/domain/card_reward/store"
+	channelStore "pickrewardapi/internal/domain/channel/store"
+	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
+
+	catalogDomain "pickrewardapi/internal/domain/catalog/domain"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	catalogService "pickrewardapi/internal/domain/catalog/service"
+)
+
+func buildContainer(cfg *config.Config) *dig.Container {
+	container := dig.New()
+
+	container.Provide(func() *config.Config { return cfg })
+
+	container.Provide(psql.NewPsql)
+	container.Provide(cache.New)
+
+	container.Provide(bankStore.New)
+	container.Provide(cardStore.New)
+	container.Provide(channelStore.New)
+	container.Provide(channelLabelStore.New)
+	container.Provide(cardRewardStore.New)
+
+	container.Provide(catalogService.New)
+
+	return container
+}
+
+func main() {
+	logPos := "[importer][main]"
+
+	file := flag.String("file", "", "catalog file, .json, .yaml, .yml or .csv")
+	format := flag.String("format", "", "catalog format, json, yaml or csv, guessed from the file extension by default")
+	dryRun := flag.Bool("dry-run", false, "print what would be created, updated or left unchanged without writing")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(flag.Args())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("config.Load failed: ", err)
+	}
+
+	catalog, err := catalogDomain.ParseFile(*file, catalogDomain.Format(*format))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos":  logPos,
+			"file": *file,
+		}).Fatal("catalogDomain.ParseFile failed: ", err)
+	}
+
+	container := buildContainer(cfg)
+
+	if err := container.Invoke(func(catalogService catalogService.CatalogService) error {
+
+		result, err := catalogService.Import(context.Background(), catalog, *dryRun)
+		if err != nil {
+			return err
+		}
+
+		printResult(result)
+		return nil
+
+	}); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("catalogService.Import failed: ", err)
+	}
+}
+
+func printResult(result *catalogDTO.ImportResultDTO) {
+
+	for _, i := range result.Items {
+		fmt.Printf("%-9s %-13s %-32s %s\n", i.Action, i.Kind, i.ID, i.Name)
+		for _, c := range i.Changes {
+			fmt.Printf("          %s: %s -> %s\n", c.Field, c.Old, c.New)
+		}
+	}
+
+	prefix := ""
+	if result.DryRun {
+		prefix = "dry run, "
+	}
+
+	fmt.Printf("%s%d created, %d updated, %d unchanged\n", prefix,
+		result.Count(catalogDTO.Create),
+		result.Count(catalogDTO.Update),
+		result.Count(catalogDTO.Unchanged),
+	)
+}
@@ -0,0 +1,115 @@
+// Command legacy migrates the banks, cards, channels, channel labels and
+// rewards of the legacy database to the primary database. It can be run
+// again, only what changed in the legacy database is written.
+//
+//	go run ./cmd/legacy -dry-run
+//
+// The arguments after the flags override the config, e.g.
+//
+//	go run ./cmd/legacy -- --postgres-migration-host=10.0.0.1
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	cache "pickrewardapi/internal/pkg/cache"
+	config "pickrewardapi/internal/pkg/config"
+	psql "pickrewardapi/internal/pkg/postgres"
+
+	bankStore "pickrewardapi/internal/domain/bank/store"
+	cardStore "pickrewardapi/internal/domain/card/store"
+	cardRewardStore "pickrewardapi/internal/domain/card_reward/store"
+	channelStore "pickrewardapi/internal/domain/channel/store"
+	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
+	legacyStore "pickrewardapi/internal/domain/legacy/store"
+
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	catalogService "pickrewardapi/internal/domain/catalog/service"
+	legacyDTO "pickrewardapi/internal/domain/legacy/dto"
+	legacyService "pickrewardapi/internal/domain/legacy/service"
+)
+
+func buildContainer(cfg *config.Config) *dig.Container {
+	container := dig.New()
+
+	container.Provide(func() *config.Config { return cfg })
+
+	container.Provide(psql.NewPsql)
+	container.Provide(cache.New)
+
+	container.Provide(bankStore.New)
+	container.Provide(cardStore.New)
+	container.Provide(channelStore.New)
+	container.Provide(channelLabelStore.New)
+	container.Provide(cardRewardStore.New)
+	container.Provide(legacyStore.New)
+
+	container.Provide(catalogService.New)
+	container.Provide(legacyService.New)
+
+	return container
+}
+
+func main() {
+	logPos := "[legacy][main]"
+
+	dryRun := flag.Bool("dry-run", false, "print what would be created, updated, left unchanged or skipped without writing")
+	flag.Parse()
+
+	cfg, err := config.Load(flag.Args())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("config.Load failed: ", err)
+	}
+
+	container := buildContainer(cfg)
+
+	if err := container.Invoke(func(sql *psql.Psql, legacyService legacyService.LegacyService) error {
+		defer sql.Close()
+
+		result, err := legacyService.Migrate(context.Background(), *dryRun)
+		if err != nil {
+			return err
+		}
+
+		printResult(result)
+		return nil
+
+	}); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("legacyService.Migrate failed: ", err)
+	}
+}
+
+func printResult(result *legacyDTO.MigrationResultDTO) {
+
+	for _, i := range result.Items {
+		fmt.Printf("%-9s %-13s %-32s %s\n", i.Action, i.Kind, i.ID, i.Name)
+		for _, c := range i.Changes {
+			fmt.Printf("          %s: %s -> %s\n", c.Field, c.Old, c.New)
+		}
+	}
+
+	for _, s := range result.Skipped {
+		fmt.Printf("%-9s %-13s %-32s %s: %s\n", "skipped", s.Kind, s.ID, s.Name, s.Reason)
+	}
+
+	prefix := ""
+	if result.DryRun {
+		prefix = "dry run, "
+	}
+
+	fmt.Printf("%s%d created, %d updated, %d unchanged, %d skipped\n", prefix,
+		result.Count(catalogDTO.Create),
+		result.Count(catalogDTO.Update),
+		result.Count(catalogDTO.Unchanged),
+		len(result.Skipped),
+	)
+}
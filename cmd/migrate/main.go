@@ -0,0 +1,128 @@
+// Command migrate applies the versioned schema migrations to the primary
+// database.
+//
+//	go run ./cmd/migrate up
+//	go run ./cmd/migrate down -steps 1
+//	go run ./cmd/migrate status
+//
+// The arguments after the flags override the config, e.g.
+//
+//	go run ./cmd/migrate up -- --postgres-host=10.0.0.1
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	config "pickrewardapi/internal/pkg/config"
+	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/schema"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: migrate up [-steps n] | down [-steps n] | status\n")
+	os.Exit(2)
+}
+
+func main() {
+	logPos := "[migrate][main]"
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	steps := 0
+	switch command {
+	case "up":
+		flags.IntVar(&steps, "steps", 0, "number of pending migrations to apply, all of them by default")
+	case "down":
+		flags.IntVar(&steps, "steps", 1, "number of applied migrations to revert")
+	case "status":
+	default:
+		usage()
+	}
+	flags.Parse(os.Args[2:])
+
+	if command == "down" && steps <= 0 {
+		log.WithFields(log.Fields{
+			"pos":   logPos,
+			"steps": steps,
+		}).Fatal("down needs a positive -steps")
+	}
+
+	cfg, err := config.Load(flags.Args())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("config.Load failed: ", err)
+	}
+
+	pool := psql.NewPrimarySql(cfg)
+	defer pool.Close()
+
+	migrator, err := schema.New(pool)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Fatal("schema.New failed: ", err)
+	}
+
+	c := context.Background()
+
+	switch command {
+	case "up":
+		done, err := migrator.Up(c, steps)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Fatal("migrator.Up failed: ", err)
+		}
+		printMigrations("applied", done)
+
+	case "down":
+		done, err := migrator.Down(c, steps)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Fatal("migrator.Down failed: ", err)
+		}
+		printMigrations("reverted", done)
+
+	case "status":
+		statuses, err := migrator.Status(c)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Fatal("migrator.Status failed: ", err)
+		}
+		printStatuses(statuses)
+	}
+}
+
+func printMigrations(action string, migrations []*schema.Migration) {
+
+	for _, m := range migrations {
+		fmt.Printf("%-9s %04d %s\n", action, m.Version, m.Name)
+	}
+
+	fmt.Printf("%d %s\n", len(migrations), action)
+}
+
+func printStatuses(statuses []*schema.Status) {
+
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if s.Applied() {
+			appliedAt = time.Unix(s.AppliedAt, 0).Format(time.RFC3339)
+		}
+		fmt.Printf("%04d %-32s %s\n", s.Version, s.Name, appliedAt)
+	}
+}
@@ -0,0 +1,686 @@
+package application
+
+import (
+	"context"
+	"crypto/subtle"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	pb "pickrewardapi/internal/application/admin/v1/proto/generated"
+
+	handler "pickrewardapi/internal/application/admin/v1/handler"
+
+	bankService "pickrewardapi/internal/domain/bank/service"
+	cardService "pickrewardapi/internal/domain/card/service"
+	cardRewardService "pickrewardapi/internal/domain/card_reward/service"
+	channelService "pickrewardapi/internal/domain/channel/service"
+	channelLabelService "pickrewardapi/internal/domain/channel_label/service"
+	commonM "pickrewardapi/internal/shared/common/model"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+// ADMIN_TOKEN_KEY is the metadata key carrying the admin token.
+const ADMIN_TOKEN_KEY = "x-admin-token"
+
+type server struct {
+	dig.In
+
+	pb.UnimplementedAdminV1Server
+
+	adminToken string
+
+	bankService         bankService.BankService
+	cardService         cardService.CardService
+	channelService      channelService.ChannelService
+	channelLabelService channelLabelService.ChannelLabelAppService
+	cardRewardService   cardRewardService.RewardAppService
+}
+
+func NewAdminServer(
+	s *grpc.Server,
+
+	adminToken string,
+
+	bankService bankService.BankService,
+	cardService cardService.CardService,
+	channelService channelService.ChannelService,
+	channelLabelService channelLabelService.ChannelLabelAppService,
+	cardRewardService cardRewardService.RewardAppService,
+) {
+	log.WithFields(log.Fields{
+		"pos": "[admin.api][NewAdminServer]",
+	}).Info("Init")
+
+	pb.RegisterAdminV1Server(s, &server{
+		adminToken: adminToken,
+
+		bankService:         bankService,
+		cardService:         cardService,
+		channelService:      channelService,
+		channelLabelService: channelLabelService,
+		cardRewardService:   cardRewardService,
+	})
+}
+
+func (s *server) authorize(ctx context.Context) error {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	tokens := md.Get(ADMIN_TOKEN_KEY)
+	if len(tokens) == 0 {
+		return status.Error(codes.Unauthenticated, "missing admin token")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(s.adminToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid admin token")
+	}
+
+	return nil
+}
+
+func (s *server) CreateBank(ctx context.Context, in *pb.BankReq) (*pb.BankReply, error) {
+	logPos := "[admin.api][CreateBank]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.CreateBank")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	bankDTO, err := s.bankService.CreateBank(ctx, handler.TransferBankReq2BankDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("bankService.CreateBank failed: ", err)
+
+		return &pb.BankReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "CreateBank failed",
+				},
+			},
+		}, err
+	}
+
+	bank := handler.TransferBankDTO2BankReply(bankDTO)
+
+	return &pb.BankReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Bank: bank,
+	}, nil
+}
+
+func (s *server) UpdateBank(ctx context.Context, in *pb.BankReq) (*pb.BankReply, error) {
+	logPos := "[admin.api][UpdateBank]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateBank")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	bankDTO, err := s.bankService.UpdateBank(ctx, handler.TransferBankReq2BankDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("bankService.UpdateBank failed: ", err)
+
+		return &pb.BankReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateBank failed",
+				},
+			},
+		}, err
+	}
+
+	bank := handler.TransferBankDTO2BankReply(bankDTO)
+
+	return &pb.BankReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Bank: bank,
+	}, nil
+}
+
+func (s *server) UpdateBankStatus(ctx context.Context, in *pb.StatusReq) (*pb.BankReply, error) {
+	logPos := "[admin.api][UpdateBankStatus]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateBankStatus")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	bankDTO, err := s.bankService.UpdateBankStatus(ctx, in.Id, commonM.Status(in.Status))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("bankService.UpdateBankStatus failed: ", err)
+
+		return &pb.BankReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateBankStatus failed",
+				},
+			},
+		}, err
+	}
+
+	bank := handler.TransferBankDTO2BankReply(bankDTO)
+
+	return &pb.BankReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Bank: bank,
+	}, nil
+}
+
+func (s *server) CreateCard(ctx context.Context, in *pb.CardReq) (*pb.CardReply, error) {
+	logPos := "[admin.api][CreateCard]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.CreateCard")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	cardDTO, err := s.cardService.CreateCard(ctx, handler.TransferCardReq2CardDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardService.CreateCard failed: ", err)
+
+		return &pb.CardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "CreateCard failed",
+				},
+			},
+		}, err
+	}
+
+	card := handler.TransferCardDTO2CardReply(cardDTO)
+
+	return &pb.CardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Card: card,
+	}, nil
+}
+
+func (s *server) UpdateCard(ctx context.Context, in *pb.CardReq) (*pb.CardReply, error) {
+	logPos := "[admin.api][UpdateCard]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateCard")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	cardDTO, err := s.cardService.UpdateCard(ctx, handler.TransferCardReq2CardDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardService.UpdateCard failed: ", err)
+
+		return &pb.CardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateCard failed",
+				},
+			},
+		}, err
+	}
+
+	card := handler.TransferCardDTO2CardReply(cardDTO)
+
+	return &pb.CardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Card: card,
+	}, nil
+}
+
+func (s *server) UpdateCardStatus(ctx context.Context, in *pb.StatusReq) (*pb.CardReply, error) {
+	logPos := "[admin.api][UpdateCardStatus]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateCardStatus")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	cardDTO, err := s.cardService.UpdateCardStatus(ctx, in.Id, commonM.Status(in.Status))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardService.UpdateCardStatus failed: ", err)
+
+		return &pb.CardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateCardStatus failed",
+				},
+			},
+		}, err
+	}
+
+	card := handler.TransferCardDTO2CardReply(cardDTO)
+
+	return &pb.CardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Card: card,
+	}, nil
+}
+
+func (s *server) CreateChannel(ctx context.Context, in *pb.ChannelReq) (*pb.ChannelReply, error) {
+	logPos := "[admin.api][CreateChannel]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.CreateChannel")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	channelDTO, err := s.channelService.CreateChannel(ctx, handler.TransferChannelReq2ChannelDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelService.CreateChannel failed: ", err)
+
+		return &pb.ChannelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "CreateChannel failed",
+				},
+			},
+		}, err
+	}
+
+	channel := handler.TransferChannelDTO2ChannelReply(channelDTO)
+
+	return &pb.ChannelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Channel: channel,
+	}, nil
+}
+
+func (s *server) UpdateChannel(ctx context.Context, in *pb.ChannelReq) (*pb.ChannelReply, error) {
+	logPos := "[admin.api][UpdateChannel]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateChannel")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	channelDTO, err := s.channelService.UpdateChannel(ctx, handler.TransferChannelReq2ChannelDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelService.UpdateChannel failed: ", err)
+
+		return &pb.ChannelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateChannel failed",
+				},
+			},
+		}, err
+	}
+
+	channel := handler.TransferChannelDTO2ChannelReply(channelDTO)
+
+	return &pb.ChannelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Channel: channel,
+	}, nil
+}
+
+func (s *server) UpdateChannelStatus(ctx context.Context, in *pb.StatusReq) (*pb.ChannelReply, error) {
+	logPos := "[admin.api][UpdateChannelStatus]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateChannelStatus")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	channelDTO, err := s.channelService.UpdateChannelStatus(ctx, in.Id, commonM.Status(in.Status))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelService.UpdateChannelStatus failed: ", err)
+
+		return &pb.ChannelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateChannelStatus failed",
+				},
+			},
+		}, err
+	}
+
+	channel := handler.TransferChannelDTO2ChannelReply(channelDTO)
+
+	return &pb.ChannelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Channel: channel,
+	}, nil
+}
+
+func (s *server) ModifyChannelLabel(ctx context.Context, in *pb.ChannelLabelReq) (*pb.ChannelLabelReply, error) {
+	logPos := "[admin.api][ModifyChannelLabel]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.ModifyChannelLabel")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	channelLabelDTO, err := s.channelLabelService.ModifyChannelLabel(ctx, handler.TransferChannelLabelReq2ChannelLabelDTO(in))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelLabelService.ModifyChannelLabel failed: ", err)
+
+		return &pb.ChannelLabelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "ModifyChannelLabel failed",
+				},
+			},
+		}, err
+	}
+
+	channelLabel := handler.TransferChannelLabelDTO2ChannelLabelReply(channelLabelDTO)
+
+	return &pb.ChannelLabelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		ChannelLabel: channelLabel,
+	}, nil
+}
+
+func (s *server) UpdateChannelLabelShow(ctx context.Context, in *pb.ChannelLabelShowReq) (*pb.ChannelLabelReply, error) {
+	logPos := "[admin.api][UpdateChannelLabelShow]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateChannelLabelShow")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	channelLabelDTO, err := s.channelLabelService.UpdateChannelLabelShow(ctx, in.Label, in.Show)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelLabelService.UpdateChannelLabelShow failed: ", err)
+
+		return &pb.ChannelLabelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateChannelLabelShow failed",
+				},
+			},
+		}, err
+	}
+
+	channelLabel := handler.TransferChannelLabelDTO2ChannelLabelReply(channelLabelDTO)
+
+	return &pb.ChannelLabelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		ChannelLabel: channelLabel,
+	}, nil
+}
+
+func (s *server) CreateReward(ctx context.Context, in *pb.RewardReq) (*pb.RewardReply, error) {
+	logPos := "[admin.api][CreateReward]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.CreateReward")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	rewardReq, err := handler.TransferRewardReq2RewardDTO(in)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("handler.TransferRewardReq2RewardDTO failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "CreateReward failed",
+				},
+			},
+		}, err
+	}
+
+	rewardDTO, err := s.cardRewardService.CreateReward(ctx, rewardReq)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.CreateReward failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "CreateReward failed",
+				},
+			},
+		}, err
+	}
+
+	reward := handler.TransferRewardDTO2RewardReply(rewardDTO)
+
+	return &pb.RewardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Reward: reward,
+	}, nil
+}
+
+func (s *server) UpdateReward(ctx context.Context, in *pb.RewardReq) (*pb.RewardReply, error) {
+	logPos := "[admin.api][UpdateReward]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateReward")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	rewardReq, err := handler.TransferRewardReq2RewardDTO(in)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("handler.TransferRewardReq2RewardDTO failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateReward failed",
+				},
+			},
+		}, err
+	}
+
+	rewardDTO, err := s.cardRewardService.UpdateReward(ctx, rewardReq)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.UpdateReward failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateReward failed",
+				},
+			},
+		}, err
+	}
+
+	reward := handler.TransferRewardDTO2RewardReply(rewardDTO)
+
+	return &pb.RewardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Reward: reward,
+	}, nil
+}
+
+func (s *server) UpdateRewardStatus(ctx context.Context, in *pb.StatusReq) (*pb.RewardReply, error) {
+	logPos := "[admin.api][UpdateRewardStatus]"
+
+	ctx, span := tracing.Start(ctx, "admin.api.UpdateRewardStatus")
+	defer span.End()
+
+	if err := s.authorize(ctx); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("authorize failed: ", err)
+		return nil, err
+	}
+
+	rewardDTO, err := s.cardRewardService.UpdateRewardStatus(ctx, in.Id, commonM.Status(in.Status))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.UpdateRewardStatus failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "UpdateRewardStatus failed",
+				},
+			},
+		}, err
+	}
+
+	reward := handler.TransferRewardDTO2RewardReply(rewardDTO)
+
+	return &pb.RewardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Reward: reward,
+	}, nil
+}
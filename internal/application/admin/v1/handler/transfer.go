@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"encoding/json"
+
+	pb "pickrewardapi/internal/application/admin/v1/proto/generated"
+
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	channelLabelDTO "pickrewardapi/internal/domain/channel_label/dto"
+	"pickrewardapi/internal/shared/common/errs"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+func TransferBankReq2BankDTO(in *pb.BankReq) *bankDTO.BankDTO {
+	return &bankDTO.BankDTO{
+		ID:         in.Id,
+		Name:       in.Name,
+		Order:      in.Order,
+		BankStatus: commonM.Status(in.BankStatus),
+	}
+}
+
+func TransferBankDTO2BankReply(b *bankDTO.BankDTO) *pb.BankReply_Bank {
+	return &pb.BankReply_Bank{
+		Id:         b.ID,
+		Name:       b.Name,
+		Order:      b.Order,
+		BankStatus: int32(b.BankStatus),
+		CreateDate: b.CreateDate,
+		UpdateDate: b.UpdateDate,
+	}
+}
+
+func TransferCardReq2CardDTO(in *pb.CardReq) *cardDTO.CardDTO {
+	return &cardDTO.CardDTO{
+		ID:           in.Id,
+		Name:         in.Name,
+		Descriptions: in.Descriptions,
+		LinkURL:      in.LinkURL,
+		BankID:       in.BankID,
+		Order:        in.Order,
+		CardStatus:   commonM.Status(in.CardStatus),
+	}
+}
+
+func TransferCardDTO2CardReply(c *cardDTO.CardDTO) *pb.CardReply_Card {
+	return &pb.CardReply_Card{
+		Id:           c.ID,
+		Name:         c.Name,
+		Descriptions: c.Descriptions,
+		LinkURL:      c.LinkURL,
+		BankID:       c.BankID,
+		Order:        c.Order,
+		CardStatus:   int32(c.CardStatus),
+		CreateDate:   c.CreateDate,
+		UpdateDate:   c.UpdateDate,
+	}
+}
+
+func TransferChannelReq2ChannelDTO(in *pb.ChannelReq) *channelDTO.ChannelDTO {
+	return &channelDTO.ChannelDTO{
+		ID:            in.Id,
+		Name:          in.Name,
+		LinkURL:       in.LinkURL,
+		ChannelType:   in.ChannelType,
+		ChannelLabels: in.ChannelLabels,
+		Order:         in.Order,
+		ChannelStatus: commonM.Status(in.ChannelStatus),
+	}
+}
+
+func TransferChannelDTO2ChannelReply(c *channelDTO.ChannelDTO) *pb.ChannelReply_Channel {
+	return &pb.ChannelReply_Channel{
+		Id:            c.ID,
+		Name:          c.Name,
+		LinkURL:       c.LinkURL,
+		ChannelType:   c.ChannelType,
+		ChannelLabels: c.ChannelLabels,
+		Order:         c.Order,
+		ChannelStatus: int32(c.ChannelStatus),
+		CreateDate:    c.CreateDate,
+		UpdateDate:    c.UpdateDate,
+	}
+}
+
+func TransferChannelLabelReq2ChannelLabelDTO(in *pb.ChannelLabelReq) *channelLabelDTO.ChannelLabelDTO {
+	return &channelLabelDTO.ChannelLabelDTO{
+		Label: in.Label,
+		Name:  in.Name,
+		Show:  in.Show,
+	}
+}
+
+func TransferChannelLabelDTO2ChannelLabelReply(c *channelLabelDTO.ChannelLabelDTO) *pb.ChannelLabelReply_ChannelLabel {
+	return &pb.ChannelLabelReply_ChannelLabel{
+		Label: c.Label,
+		Name:  c.Name,
+		Show:  c.Show,
+	}
+}
+
+func TransferRewardReq2RewardDTO(in *pb.RewardReq) (*rewardDTO.RewardDTO, error) {
+
+	var description json.RawMessage
+	if in.Description != "" {
+		if !json.Valid([]byte(in.Description)) {
+			return nil, errs.NewInvalidArgument("reward description is not valid json")
+		}
+		description = json.RawMessage(in.Description)
+	}
+
+	return &rewardDTO.RewardDTO{
+		ID:           in.Id,
+		CardID:       in.CardID,
+		Name:         in.Name,
+		Description:  description,
+		StartDate:    in.StartDate,
+		EndDate:      in.EndDate,
+		Currency:     in.Currency,
+		RewardType:   in.RewardType,
+		Order:        in.Order,
+		Rule:         TransferRule2RuleDTO(in.Rule),
+		RewardStatus: commonM.Status(in.RewardStatus),
+	}, nil
+}
+
+func TransferRule2RuleDTO(rule *pb.Rule) *rewardDTO.RewardRuleDTO {
+
+	if rule == nil {
+		return nil
+	}
+
+	constraints := []*commonM.Constraint{}
+	for _, c := range rule.Constraints {
+		constraints = append(constraints, &commonM.Constraint{
+			ConstraintType: commonM.ConstraintType(c.ConstraintType),
+			ConstraintName: c.ConstraintName,
+			WeekDays:       c.WeekDays,
+			LimitCount:     c.LimitCount,
+		})
+	}
+
+	return &rewardDTO.RewardRuleDTO{
+		CalculateType:  rewardDTO.CalculateType(rule.CalculateType),
+		Percentage:     rule.Percentage,
+		Fixed:          rule.Fixed,
+		MinCost:        rule.MinCost,
+		PeriodMinCost:  rule.PeriodMinCost,
+		TransactionCap: rule.TransactionCap,
+		PeriodCap:      rule.PeriodCap,
+		PeriodType:     rewardDTO.PeriodType(rule.PeriodType),
+		ChannelIDs:     rule.ChannelIDs,
+		ChannelLabels:  rule.ChannelLabels,
+		PayIDs:         rule.PayIDs,
+		Constraints:    constraints,
+	}
+}
+
+func TransferRewardDTO2RewardReply(r *rewardDTO.RewardDTO) *pb.RewardReply_Reward {
+	return &pb.RewardReply_Reward{
+		Id:           r.ID,
+		CardID:       r.CardID,
+		Name:         r.Name,
+		Description:  string(r.Description),
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		Currency:     r.Currency,
+		RewardType:   r.RewardType,
+		Order:        r.Order,
+		Rule:         TransferRuleDTO2Rule(r.Rule),
+		RewardStatus: int32(r.RewardStatus),
+		CreateDate:   r.CreateDate,
+		UpdateDate:   r.UpdateDate,
+	}
+}
+
+func TransferRuleDTO2Rule(ruleDTO *rewardDTO.RewardRuleDTO) *pb.Rule {
+
+	if ruleDTO == nil {
+		return nil
+	}
+
+	constraints := []*pb.Rule_Constraint{}
+	for _, c := range ruleDTO.Constraints {
+		constraints = append(constraints, &pb.Rule_Constraint{
+			ConstraintType: int32(c.ConstraintType),
+			ConstraintName: c.ConstraintName,
+			WeekDays:       c.WeekDays,
+			LimitCount:     c.LimitCount,
+		})
+	}
+
+	return &pb.Rule{
+		CalculateType:  int32(ruleDTO.CalculateType),
+		Percentage:     ruleDTO.Percentage,
+		Fixed:          ruleDTO.Fixed,
+		MinCost:        ruleDTO.MinCost,
+		PeriodMinCost:  ruleDTO.PeriodMinCost,
+		TransactionCap: ruleDTO.TransactionCap,
+		PeriodCap:      ruleDTO.PeriodCap,
+		PeriodType:     int32(ruleDTO.PeriodType),
+		ChannelIDs:     ruleDTO.ChannelIDs,
+		ChannelLabels:  ruleDTO.ChannelLabels,
+		PayIDs:         ruleDTO.PayIDs,
+		Constraints:    constraints,
+	}
+}
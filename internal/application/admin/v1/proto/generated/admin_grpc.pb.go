@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: admin.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AdminV1Client is the client API for AdminV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminV1Client interface {
+	CreateBank(ctx context.Context, in *BankReq, opts ...grpc.CallOption) (*BankReply, error)
+	UpdateBank(ctx context.Context, in *BankReq, opts ...grpc.CallOption) (*BankReply, error)
+	UpdateBankStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*BankReply, error)
+	CreateCard(ctx context.Context, in *CardReq, opts ...grpc.CallOption) (*CardReply, error)
+	UpdateCard(ctx context.Context, in *CardReq, opts ...grpc.CallOption) (*CardReply, error)
+	UpdateCardStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*CardReply, error)
+	CreateChannel(ctx context.Context, in *ChannelReq, opts ...grpc.CallOption) (*ChannelReply, error)
+	UpdateChannel(ctx context.Context, in *ChannelReq, opts ...grpc.CallOption) (*ChannelReply, error)
+	UpdateChannelStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*ChannelReply, error)
+	ModifyChannelLabel(ctx context.Context, in *ChannelLabelReq, opts ...grpc.CallOption) (*ChannelLabelReply, error)
+	UpdateChannelLabelShow(ctx context.Context, in *ChannelLabelShowReq, opts ...grpc.CallOption) (*ChannelLabelReply, error)
+	CreateReward(ctx context.Context, in *RewardReq, opts ...grpc.CallOption) (*RewardReply, error)
+	UpdateReward(ctx context.Context, in *RewardReq, opts ...grpc.CallOption) (*RewardReply, error)
+	UpdateRewardStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*RewardReply, error)
+}
+
+type adminV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminV1Client(cc grpc.ClientConnInterface) AdminV1Client {
+	return &adminV1Client{cc}
+}
+
+func (c *adminV1Client) CreateBank(ctx context.Context, in *BankReq, opts ...grpc.CallOption) (*BankReply, error) {
+	out := new(BankReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/CreateBank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateBank(ctx context.Context, in *BankReq, opts ...grpc.CallOption) (*BankReply, error) {
+	out := new(BankReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateBank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateBankStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*BankReply, error) {
+	out := new(BankReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateBankStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) CreateCard(ctx context.Context, in *CardReq, opts ...grpc.CallOption) (*CardReply, error) {
+	out := new(CardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/CreateCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateCard(ctx context.Context, in *CardReq, opts ...grpc.CallOption) (*CardReply, error) {
+	out := new(CardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateCardStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*CardReply, error) {
+	out := new(CardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateCardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) CreateChannel(ctx context.Context, in *ChannelReq, opts ...grpc.CallOption) (*ChannelReply, error) {
+	out := new(ChannelReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/CreateChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateChannel(ctx context.Context, in *ChannelReq, opts ...grpc.CallOption) (*ChannelReply, error) {
+	out := new(ChannelReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateChannelStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*ChannelReply, error) {
+	out := new(ChannelReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateChannelStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) ModifyChannelLabel(ctx context.Context, in *ChannelLabelReq, opts ...grpc.CallOption) (*ChannelLabelReply, error) {
+	out := new(ChannelLabelReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/ModifyChannelLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateChannelLabelShow(ctx context.Context, in *ChannelLabelShowReq, opts ...grpc.CallOption) (*ChannelLabelReply, error) {
+	out := new(ChannelLabelReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateChannelLabelShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) CreateReward(ctx context.Context, in *RewardReq, opts ...grpc.CallOption) (*RewardReply, error) {
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/CreateReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateReward(ctx context.Context, in *RewardReq, opts ...grpc.CallOption) (*RewardReply, error) {
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1Client) UpdateRewardStatus(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*RewardReply, error) {
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, "/admin.v1.AdminV1/UpdateRewardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminV1Server is the server API for AdminV1 service.
+// All implementations must embed UnimplementedAdminV1Server
+// for forward compatibility
+type AdminV1Server interface {
+	CreateBank(context.Context, *BankReq) (*BankReply, error)
+	UpdateBank(context.Context, *BankReq) (*BankReply, error)
+	UpdateBankStatus(context.Context, *StatusReq) (*BankReply, error)
+	CreateCard(context.Context, *CardReq) (*CardReply, error)
+	UpdateCard(context.Context, *CardReq) (*CardReply, error)
+	UpdateCardStatus(context.Context, *StatusReq) (*CardReply, error)
+	CreateChannel(context.Context, *ChannelReq) (*ChannelReply, error)
+	UpdateChannel(context.Context, *ChannelReq) (*ChannelReply, error)
+	UpdateChannelStatus(context.Context, *StatusReq) (*ChannelReply, error)
+	ModifyChannelLabel(context.Context, *ChannelLabelReq) (*ChannelLabelReply, error)
+	UpdateChannelLabelShow(context.Context, *ChannelLabelShowReq) (*ChannelLabelReply, error)
+	CreateReward(context.Context, *RewardReq) (*RewardReply, error)
+	UpdateReward(context.Context, *RewardReq) (*RewardReply, error)
+	UpdateRewardStatus(context.Context, *StatusReq) (*RewardReply, error)
+	mustEmbedUnimplementedAdminV1Server()
+}
+
+// UnimplementedAdminV1Server must be embedded to have forward compatible implementations.
+type UnimplementedAdminV1Server struct {
+}
+
+func (UnimplementedAdminV1Server) CreateBank(context.Context, *BankReq) (*BankReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBank not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateBank(context.Context, *BankReq) (*BankReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBank not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateBankStatus(context.Context, *StatusReq) (*BankReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankStatus not implemented")
+}
+func (UnimplementedAdminV1Server) CreateCard(context.Context, *CardReq) (*CardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateCard(context.Context, *CardReq) (*CardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateCardStatus(context.Context, *StatusReq) (*CardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCardStatus not implemented")
+}
+func (UnimplementedAdminV1Server) CreateChannel(context.Context, *ChannelReq) (*ChannelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateChannel(context.Context, *ChannelReq) (*ChannelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateChannelStatus(context.Context, *StatusReq) (*ChannelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelStatus not implemented")
+}
+func (UnimplementedAdminV1Server) ModifyChannelLabel(context.Context, *ChannelLabelReq) (*ChannelLabelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyChannelLabel not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateChannelLabelShow(context.Context, *ChannelLabelShowReq) (*ChannelLabelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelLabelShow not implemented")
+}
+func (UnimplementedAdminV1Server) CreateReward(context.Context, *RewardReq) (*RewardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReward not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateReward(context.Context, *RewardReq) (*RewardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReward not implemented")
+}
+func (UnimplementedAdminV1Server) UpdateRewardStatus(context.Context, *StatusReq) (*RewardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRewardStatus not implemented")
+}
+func (UnimplementedAdminV1Server) mustEmbedUnimplementedAdminV1Server() {}
+
+// UnsafeAdminV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminV1Server will
+// result in compilation errors.
+type UnsafeAdminV1Server interface {
+	mustEmbedUnimplementedAdminV1Server()
+}
+
+func RegisterAdminV1Server(s grpc.ServiceRegistrar, srv AdminV1Server) {
+	s.RegisterService(&AdminV1_ServiceDesc, srv)
+}
+
+func _AdminV1_CreateBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).CreateBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/CreateBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).CreateBank(ctx, req.(*BankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateBank(ctx, req.(*BankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateBankStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateBankStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateBankStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateBankStatus(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).CreateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/CreateCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).CreateCard(ctx, req.(*CardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateCard(ctx, req.(*CardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateCardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateCardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateCardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateCardStatus(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/CreateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).CreateChannel(ctx, req.(*ChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateChannel(ctx, req.(*ChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateChannelStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateChannelStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateChannelStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateChannelStatus(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_ModifyChannelLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelLabelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).ModifyChannelLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/ModifyChannelLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).ModifyChannelLabel(ctx, req.(*ChannelLabelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateChannelLabelShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelLabelShowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateChannelLabelShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateChannelLabelShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateChannelLabelShow(ctx, req.(*ChannelLabelShowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_CreateReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).CreateReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/CreateReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).CreateReward(ctx, req.(*RewardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateReward(ctx, req.(*RewardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1_UpdateRewardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1Server).UpdateRewardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.v1.AdminV1/UpdateRewardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1Server).UpdateRewardStatus(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminV1_ServiceDesc is the grpc.ServiceDesc for AdminV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1.AdminV1",
+	HandlerType: (*AdminV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBank",
+			Handler:    _AdminV1_CreateBank_Handler,
+		},
+		{
+			MethodName: "UpdateBank",
+			Handler:    _AdminV1_UpdateBank_Handler,
+		},
+		{
+			MethodName: "UpdateBankStatus",
+			Handler:    _AdminV1_UpdateBankStatus_Handler,
+		},
+		{
+			MethodName: "CreateCard",
+			Handler:    _AdminV1_CreateCard_Handler,
+		},
+		{
+			MethodName: "UpdateCard",
+			Handler:    _AdminV1_UpdateCard_Handler,
+		},
+		{
+			MethodName: "UpdateCardStatus",
+			Handler:    _AdminV1_UpdateCardStatus_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _AdminV1_CreateChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _AdminV1_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannelStatus",
+			Handler:    _AdminV1_UpdateChannelStatus_Handler,
+		},
+		{
+			MethodName: "ModifyChannelLabel",
+			Handler:    _AdminV1_ModifyChannelLabel_Handler,
+		},
+		{
+			MethodName: "UpdateChannelLabelShow",
+			Handler:    _AdminV1_UpdateChannelLabelShow_Handler,
+		},
+		{
+			MethodName: "CreateReward",
+			Handler:    _AdminV1_CreateReward_Handler,
+		},
+		{
+			MethodName: "UpdateReward",
+			Handler:    _AdminV1_UpdateReward_Handler,
+		},
+		{
+			MethodName: "UpdateRewardStatus",
+			Handler:    _AdminV1_UpdateRewardStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
@@ -2,17 +2,22 @@ package application
 
 import (
 	"context"
-	"encoding/json"
 
 	log "github.com/sirupsen/logrus"
 
 	"go.uber.org/dig"
 	"google.golang.org/grpc"
 
+	baseCtx "pickrewardapi/base/ctx"
+
 	"pickrewardapi/internal/application/bank/v1/handler"
 	pb "pickrewardapi/internal/application/bank/v1/proto/generated"
 
 	bankService "pickrewardapi/internal/domain/bank/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type server struct {
@@ -32,23 +37,27 @@ func NewBankServer(
 		"pos": "[app.bank][NewBankServer]",
 	}).Info("Init")
 
-	pb.RegisterBankV1Server(s, &server{
+	pb.RegisterBankV1Server(s, NewBankV1Server(bankService))
+}
+
+// NewBankV1Server returns the BankV1 implementation registered by
+// NewBankServer, the REST gateway calls it in process.
+func NewBankV1Server(bankService bankService.BankService) pb.BankV1Server {
+	return &server{
 		bankService: bankService,
-	})
+	}
 }
 
 func (s *server) GetAllBanks(ctx context.Context, in *pb.AllBanksReq) (*pb.BanksReply, error) {
 	logPos := "[bank.api][GetAllBanks]"
 
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "bank.api.GetAllBanks")
+	defer span.End()
 
-	banksDTOs, err := s.bankService.GetAllBanks(ctx)
+	banksDTOs, pageInfo, err := s.bankService.GetAllBanks(ctx, handler.TransferPageReq(in.GetPage()))
 
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("bankService.GetAllBanks failed: ", err)
 
@@ -56,26 +65,21 @@ func (s *server) GetAllBanks(ctx context.Context, in *pb.AllBanksReq) (*pb.Banks
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetAllBanks failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
 	banks := handler.TransferBankDTOsToBank(banksDTOs)
 
-	banksLog, _ := json.Marshal(banks)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(banksLog),
-	}).Info("Response")
-
 	return &pb.BanksReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Banks: banks,
+		Page:  handler.TransferPageInfo(pageInfo),
 	}, nil
 
 }
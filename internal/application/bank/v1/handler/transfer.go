@@ -4,6 +4,7 @@ import (
 	pb "pickrewardapi/internal/application/bank/v1/proto/generated"
 
 	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	"pickrewardapi/internal/shared/common/page"
 )
 
 func TransferBankDTOsToBank(bankDTOs []*bankDTO.BankDTO) []*pb.BanksReply_Bank {
@@ -23,3 +24,17 @@ func TransferBankDTOsToBank(bankDTOs []*bankDTO.BankDTO) []*pb.BanksReply_Bank {
 
 	return banks
 }
+
+func TransferPageReq(p *pb.PageReq) page.Request {
+	return page.Request{
+		Size:  p.GetPageSize(),
+		Token: p.GetPageToken(),
+	}
+}
+
+func TransferPageInfo(info *page.Info) *pb.PageReply {
+	return &pb.PageReply{
+		NextPageToken: info.NextToken,
+		TotalCount:    info.Total,
+	}
+}
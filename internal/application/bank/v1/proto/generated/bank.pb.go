@@ -20,6 +20,9 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Reply is the status of a call. A failed call returns a gRPC status error
+// with an ErrorInfo detail, clients sending the metadata x-error-mode: reply
+// get the reply with status 1 and error set and no gRPC error instead.
 type Reply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -130,16 +133,132 @@ func (x *Error) GetErrorMessage() string {
 	return ""
 }
 
+// PageReq asks for a page of a list. pageSize defaults to 20 and is capped
+// at 100, pageToken is the nextPageToken of the previous page.
+type PageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *PageReq) Reset() {
+	*x = PageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bank_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReq) ProtoMessage() {}
+
+func (x *PageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReq.ProtoReflect.Descriptor instead.
+func (*PageReq) Descriptor() ([]byte, []int) {
+	return file_bank_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// PageReply has an empty nextPageToken on the last page, totalCount counts
+// the items of all pages.
+type PageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string `protobuf:"bytes,1,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int32  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+}
+
+func (x *PageReply) Reset() {
+	*x = PageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bank_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReply) ProtoMessage() {}
+
+func (x *PageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bank_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReply.ProtoReflect.Descriptor instead.
+func (*PageReply) Descriptor() ([]byte, []int) {
+	return file_bank_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PageReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type AllBanksReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Page *PageReq `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *AllBanksReq) Reset() {
 	*x = AllBanksReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bank_proto_msgTypes[2]
+		mi := &file_bank_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +271,7 @@ func (x *AllBanksReq) String() string {
 func (*AllBanksReq) ProtoMessage() {}
 
 func (x *AllBanksReq) ProtoReflect() protoreflect.Message {
-	mi := &file_bank_proto_msgTypes[2]
+	mi := &file_bank_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +284,14 @@ func (x *AllBanksReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllBanksReq.ProtoReflect.Descriptor instead.
 func (*AllBanksReq) Descriptor() ([]byte, []int) {
-	return file_bank_proto_rawDescGZIP(), []int{2}
+	return file_bank_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AllBanksReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
+	}
+	return nil
 }
 
 type BanksReply struct {
@@ -175,12 +301,13 @@ type BanksReply struct {
 
 	Reply *Reply             `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 	Banks []*BanksReply_Bank `protobuf:"bytes,2,rep,name=banks,proto3" json:"banks,omitempty"`
+	Page  *PageReply         `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *BanksReply) Reset() {
 	*x = BanksReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bank_proto_msgTypes[3]
+		mi := &file_bank_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -193,7 +320,7 @@ func (x *BanksReply) String() string {
 func (*BanksReply) ProtoMessage() {}
 
 func (x *BanksReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bank_proto_msgTypes[3]
+	mi := &file_bank_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +333,7 @@ func (x *BanksReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanksReply.ProtoReflect.Descriptor instead.
 func (*BanksReply) Descriptor() ([]byte, []int) {
-	return file_bank_proto_rawDescGZIP(), []int{3}
+	return file_bank_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BanksReply) GetReply() *Reply {
@@ -223,6 +350,13 @@ func (x *BanksReply) GetBanks() []*BanksReply_Bank {
 	return nil
 }
 
+func (x *BanksReply) GetPage() *PageReply {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type BanksReply_Bank struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -237,7 +371,7 @@ type BanksReply_Bank struct {
 func (x *BanksReply_Bank) Reset() {
 	*x = BanksReply_Bank{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bank_proto_msgTypes[4]
+		mi := &file_bank_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +384,7 @@ func (x *BanksReply_Bank) String() string {
 func (*BanksReply_Bank) ProtoMessage() {}
 
 func (x *BanksReply_Bank) ProtoReflect() protoreflect.Message {
-	mi := &file_bank_proto_msgTypes[4]
+	mi := &file_bank_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +397,7 @@ func (x *BanksReply_Bank) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanksReply_Bank.ProtoReflect.Descriptor instead.
 func (*BanksReply_Bank) Descriptor() ([]byte, []int) {
-	return file_bank_proto_rawDescGZIP(), []int{3, 0}
+	return file_bank_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *BanksReply_Bank) GetId() string {
@@ -307,28 +441,43 @@ var file_bank_proto_rawDesc = []byte{
 	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x42, 0x61,
-	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x6b, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x62,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x42, 0x61, 0x6e, 0x6b, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0x60, 0x0a, 0x04, 0x42,
-	0x61, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x44, 0x0a,
-	0x06, 0x42, 0x61, 0x6e, 0x6b, 0x56, 0x31, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x6c, 0x6c, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62,
-	0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x70, 0x69, 0x63, 0x6b, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x07, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x09,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x33, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62,
+	0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42, 0x61,
+	0x6e, 0x6b, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x1a, 0x60, 0x0a, 0x04, 0x42, 0x61, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x32, 0x44, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6b, 0x56, 0x31, 0x12, 0x3a, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x62,
+	0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x42, 0x61, 0x6e, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x70, 0x69, 0x63,
+	0x6b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -343,25 +492,29 @@ func file_bank_proto_rawDescGZIP() []byte {
 	return file_bank_proto_rawDescData
 }
 
-var file_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_bank_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_bank_proto_goTypes = []interface{}{
 	(*Reply)(nil),           // 0: bank.v1.Reply
 	(*Error)(nil),           // 1: bank.v1.Error
-	(*AllBanksReq)(nil),     // 2: bank.v1.AllBanksReq
-	(*BanksReply)(nil),      // 3: bank.v1.BanksReply
-	(*BanksReply_Bank)(nil), // 4: bank.v1.BanksReply.Bank
+	(*PageReq)(nil),         // 2: bank.v1.PageReq
+	(*PageReply)(nil),       // 3: bank.v1.PageReply
+	(*AllBanksReq)(nil),     // 4: bank.v1.AllBanksReq
+	(*BanksReply)(nil),      // 5: bank.v1.BanksReply
+	(*BanksReply_Bank)(nil), // 6: bank.v1.BanksReply.Bank
 }
 var file_bank_proto_depIdxs = []int32{
 	1, // 0: bank.v1.Reply.error:type_name -> bank.v1.Error
-	0, // 1: bank.v1.BanksReply.reply:type_name -> bank.v1.Reply
-	4, // 2: bank.v1.BanksReply.banks:type_name -> bank.v1.BanksReply.Bank
-	2, // 3: bank.v1.BankV1.GetAllBanks:input_type -> bank.v1.AllBanksReq
-	3, // 4: bank.v1.BankV1.GetAllBanks:output_type -> bank.v1.BanksReply
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 1: bank.v1.AllBanksReq.page:type_name -> bank.v1.PageReq
+	0, // 2: bank.v1.BanksReply.reply:type_name -> bank.v1.Reply
+	6, // 3: bank.v1.BanksReply.banks:type_name -> bank.v1.BanksReply.Bank
+	3, // 4: bank.v1.BanksReply.page:type_name -> bank.v1.PageReply
+	4, // 5: bank.v1.BankV1.GetAllBanks:input_type -> bank.v1.AllBanksReq
+	5, // 6: bank.v1.BankV1.GetAllBanks:output_type -> bank.v1.BanksReply
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_bank_proto_init() }
@@ -395,7 +548,7 @@ func file_bank_proto_init() {
 			}
 		}
 		file_bank_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllBanksReq); i {
+			switch v := v.(*PageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -407,7 +560,7 @@ func file_bank_proto_init() {
 			}
 		}
 		file_bank_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanksReply); i {
+			switch v := v.(*PageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -419,6 +572,30 @@ func file_bank_proto_init() {
 			}
 		}
 		file_bank_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllBanksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bank_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanksReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bank_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BanksReply_Bank); i {
 			case 0:
 				return &v.state
@@ -437,7 +614,7 @@ func file_bank_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bank_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,17 +2,22 @@ package application
 
 import (
 	"context"
-	"encoding/json"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 	"google.golang.org/grpc"
 
+	baseCtx "pickrewardapi/base/ctx"
+
 	pb "pickrewardapi/internal/application/card/v1/proto/generated"
 
 	handler "pickrewardapi/internal/application/card/v1/handler"
 
 	cardService "pickrewardapi/internal/domain/card/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type server struct {
@@ -32,22 +37,26 @@ func NewCardServer(
 		"pos": "[card.api][NewCardServer]",
 	}).Info("Init")
 
-	pb.RegisterCardV1Server(s, &server{
+	pb.RegisterCardV1Server(s, NewCardV1Server(cardService))
+}
+
+// NewCardV1Server returns the CardV1 implementation registered by
+// NewCardServer, the REST gateway calls it in process.
+func NewCardV1Server(cardService cardService.CardService) pb.CardV1Server {
+	return &server{
 		cardService: cardService,
-	})
+	}
 }
 
 func (s *server) GetCardsByBankID(ctx context.Context, in *pb.CardsByBankIDReq) (*pb.CardsReply, error) {
 	logPos := "[card.api][GetCardsByBankID]"
 
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "card.api.GetCardsByBankID")
+	defer span.End()
 
-	cardDTOs, err := s.cardService.GetCardsByBankID(ctx, in.Id)
+	cardDTOs, pageInfo, err := s.cardService.GetCardsByBankID(ctx, in.Id, handler.TransferPageReq(in.GetPage()))
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardService.GetCardsByBankID failed: ", err)
 
@@ -55,40 +64,33 @@ func (s *server) GetCardsByBankID(ctx context.Context, in *pb.CardsByBankIDReq)
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetCardsByBankID failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
 	cards := handler.TransferCardsDTO2CardsReply(cardDTOs)
 
-	cardsLog, _ := json.Marshal(cards)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(cardsLog),
-	}).Info("Response")
-
 	return &pb.CardsReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Cards: cards,
+		Page:  handler.TransferPageInfo(pageInfo),
 	}, nil
 }
 
-func (s *server) GetLatestCards(ctx context.Context, in *pb.EmptyReq) (*pb.CardsReply, error) {
+func (s *server) GetLatestCards(ctx context.Context, in *pb.LatestCardsReq) (*pb.CardsReply, error) {
 	logPos := "[card.api][GetLatestCards]"
 
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "card.api.GetLatestCards")
+	defer span.End()
 
-	cardDTOs, err := s.cardService.GetLatestCards(ctx)
+	cardDTOs, pageInfo, err := s.cardService.GetLatestCards(ctx, handler.TransferPageReq(in.GetPage()))
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardService.GetLatestCards failed: ", err)
 
@@ -96,40 +98,33 @@ func (s *server) GetLatestCards(ctx context.Context, in *pb.EmptyReq) (*pb.Cards
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetLatestCards failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
 	cards := handler.TransferCardsDTO2CardsReply(cardDTOs)
 
-	cardsLog, _ := json.Marshal(cards)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(cardsLog),
-	}).Info("Response")
-
 	return &pb.CardsReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Cards: cards,
+		Page:  handler.TransferPageInfo(pageInfo),
 	}, nil
 }
 
 func (s *server) GetCardByID(ctx context.Context, in *pb.CardIDReq) (*pb.CardReply, error) {
 	logPos := "[card.api][GetCardByID]"
 
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "card.api.GetCardByID")
+	defer span.End()
 
 	cardDTO, err := s.cardService.GetCardByID(ctx, in.Id)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardService.GetCardByID failed: ", err)
 
@@ -137,19 +132,14 @@ func (s *server) GetCardByID(ctx context.Context, in *pb.CardIDReq) (*pb.CardRep
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetCardByID failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
 	card := handler.TransferCardDTO2CardReply(cardDTO)
-	cardsLog, _ := json.Marshal(card)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(cardsLog),
-	}).Info("Response")
 
 	return &pb.CardReply{
 		Reply: &pb.Reply{
@@ -162,14 +152,12 @@ func (s *server) GetCardByID(ctx context.Context, in *pb.CardIDReq) (*pb.CardRep
 func (s *server) SearchCard(ctx context.Context, in *pb.SearchCardReq) (*pb.CardsReply, error) {
 	logPos := "[card.api][SearchCard]"
 
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "card.api.SearchCard")
+	defer span.End()
 
-	cardDTOs, err := s.cardService.SearchCard(ctx, in.Keyword)
+	cardDTOs, pageInfo, err := s.cardService.SearchCard(ctx, in.Keyword, handler.TransferPageReq(in.GetPage()))
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardService.SearchCard failed: ", err)
 
@@ -177,24 +165,20 @@ func (s *server) SearchCard(ctx context.Context, in *pb.SearchCardReq) (*pb.Card
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "SearchCard failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
 	cards := handler.TransferCardsDTO2CardsReply(cardDTOs)
-	cardsLog, _ := json.Marshal(cards)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(cardsLog),
-	}).Info("Response")
 
 	return &pb.CardsReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Cards: cards,
+		Page:  handler.TransferPageInfo(pageInfo),
 	}, nil
 }
@@ -3,6 +3,8 @@ package handler
 import (
 	cardDTO "pickrewardapi/internal/domain/card/dto"
 
+	"pickrewardapi/internal/shared/common/page"
+
 	pb "pickrewardapi/internal/application/card/v1/proto/generated"
 )
 
@@ -41,3 +43,17 @@ func TransferCardDTO2CardReply(cardDTO *cardDTO.CardDTO) *pb.CardReply_Card {
 		UpdateDate:   cardDTO.UpdateDate,
 	}
 }
+
+func TransferPageReq(p *pb.PageReq) page.Request {
+	return page.Request{
+		Size:  p.GetPageSize(),
+		Token: p.GetPageToken(),
+	}
+}
+
+func TransferPageInfo(info *page.Info) *pb.PageReply {
+	return &pb.PageReply{
+		NextPageToken: info.NextToken,
+		TotalCount:    info.Total,
+	}
+}
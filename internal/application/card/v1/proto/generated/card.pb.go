@@ -20,6 +20,9 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Reply is the status of a call. A failed call returns a gRPC status error
+// with an ErrorInfo detail, clients sending the metadata x-error-mode: reply
+// get the reply with status 1 and error set and no gRPC error instead.
 type Reply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -130,18 +133,133 @@ func (x *Error) GetErrorMessage() string {
 	return ""
 }
 
+// PageReq asks for a page of a list. pageSize defaults to 20 and is capped
+// at 100, pageToken is the nextPageToken of the previous page.
+type PageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *PageReq) Reset() {
+	*x = PageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReq) ProtoMessage() {}
+
+func (x *PageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReq.ProtoReflect.Descriptor instead.
+func (*PageReq) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// PageReply has an empty nextPageToken on the last page, totalCount counts
+// the items of all pages.
+type PageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string `protobuf:"bytes,1,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int32  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+}
+
+func (x *PageReply) Reset() {
+	*x = PageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReply) ProtoMessage() {}
+
+func (x *PageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReply.ProtoReflect.Descriptor instead.
+func (*PageReply) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PageReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type CardsByBankIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page *PageReq `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *CardsByBankIDReq) Reset() {
 	*x = CardsByBankIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[2]
+		mi := &file_card_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -154,7 +272,7 @@ func (x *CardsByBankIDReq) String() string {
 func (*CardsByBankIDReq) ProtoMessage() {}
 
 func (x *CardsByBankIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[2]
+	mi := &file_card_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +285,7 @@ func (x *CardsByBankIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardsByBankIDReq.ProtoReflect.Descriptor instead.
 func (*CardsByBankIDReq) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{2}
+	return file_card_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CardsByBankIDReq) GetId() string {
@@ -177,6 +295,60 @@ func (x *CardsByBankIDReq) GetId() string {
 	return ""
 }
 
+func (x *CardsByBankIDReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type LatestCardsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page *PageReq `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *LatestCardsReq) Reset() {
+	*x = LatestCardsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestCardsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestCardsReq) ProtoMessage() {}
+
+func (x *LatestCardsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestCardsReq.ProtoReflect.Descriptor instead.
+func (*LatestCardsReq) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LatestCardsReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type CardIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -188,7 +360,7 @@ type CardIDReq struct {
 func (x *CardIDReq) Reset() {
 	*x = CardIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[3]
+		mi := &file_card_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -201,7 +373,7 @@ func (x *CardIDReq) String() string {
 func (*CardIDReq) ProtoMessage() {}
 
 func (x *CardIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[3]
+	mi := &file_card_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +386,7 @@ func (x *CardIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardIDReq.ProtoReflect.Descriptor instead.
 func (*CardIDReq) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{3}
+	return file_card_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CardIDReq) GetId() string {
@@ -231,12 +403,13 @@ type CardsReply struct {
 
 	Reply *Reply             `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 	Cards []*CardsReply_Card `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	Page  *PageReply         `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *CardsReply) Reset() {
 	*x = CardsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[4]
+		mi := &file_card_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -249,7 +422,7 @@ func (x *CardsReply) String() string {
 func (*CardsReply) ProtoMessage() {}
 
 func (x *CardsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[4]
+	mi := &file_card_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +435,7 @@ func (x *CardsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardsReply.ProtoReflect.Descriptor instead.
 func (*CardsReply) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{4}
+	return file_card_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CardsReply) GetReply() *Reply {
@@ -279,6 +452,13 @@ func (x *CardsReply) GetCards() []*CardsReply_Card {
 	return nil
 }
 
+func (x *CardsReply) GetPage() *PageReply {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type CardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -291,7 +471,7 @@ type CardReply struct {
 func (x *CardReply) Reset() {
 	*x = CardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[5]
+		mi := &file_card_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +484,7 @@ func (x *CardReply) String() string {
 func (*CardReply) ProtoMessage() {}
 
 func (x *CardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[5]
+	mi := &file_card_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +497,7 @@ func (x *CardReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardReply.ProtoReflect.Descriptor instead.
 func (*CardReply) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{5}
+	return file_card_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CardReply) GetReply() *Reply {
@@ -339,13 +519,14 @@ type SearchCardReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Keyword string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Page    *PageReq `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *SearchCardReq) Reset() {
 	*x = SearchCardReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[6]
+		mi := &file_card_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -358,7 +539,7 @@ func (x *SearchCardReq) String() string {
 func (*SearchCardReq) ProtoMessage() {}
 
 func (x *SearchCardReq) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[6]
+	mi := &file_card_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +552,7 @@ func (x *SearchCardReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchCardReq.ProtoReflect.Descriptor instead.
 func (*SearchCardReq) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{6}
+	return file_card_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SearchCardReq) GetKeyword() string {
@@ -381,42 +562,11 @@ func (x *SearchCardReq) GetKeyword() string {
 	return ""
 }
 
-type EmptyReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *EmptyReq) Reset() {
-	*x = EmptyReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EmptyReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EmptyReq) ProtoMessage() {}
-
-func (x *EmptyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SearchCardReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EmptyReq.ProtoReflect.Descriptor instead.
-func (*EmptyReq) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{7}
+	return nil
 }
 
 type CardsReply_Card struct {
@@ -438,7 +588,7 @@ type CardsReply_Card struct {
 func (x *CardsReply_Card) Reset() {
 	*x = CardsReply_Card{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[8]
+		mi := &file_card_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -451,7 +601,7 @@ func (x *CardsReply_Card) String() string {
 func (*CardsReply_Card) ProtoMessage() {}
 
 func (x *CardsReply_Card) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[8]
+	mi := &file_card_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +614,7 @@ func (x *CardsReply_Card) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardsReply_Card.ProtoReflect.Descriptor instead.
 func (*CardsReply_Card) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{4, 0}
+	return file_card_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *CardsReply_Card) GetId() string {
@@ -549,7 +699,7 @@ type CardReply_Card struct {
 func (x *CardReply_Card) Reset() {
 	*x = CardReply_Card{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[9]
+		mi := &file_card_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +712,7 @@ func (x *CardReply_Card) String() string {
 func (*CardReply_Card) ProtoMessage() {}
 
 func (x *CardReply_Card) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[9]
+	mi := &file_card_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +725,7 @@ func (x *CardReply_Card) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardReply_Card.ProtoReflect.Descriptor instead.
 func (*CardReply_Card) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{5, 0}
+	return file_card_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *CardReply_Card) GetId() string {
@@ -654,78 +804,98 @@ var file_card_proto_rawDesc = []byte{
 	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x73,
-	0x42, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x09, 0x43,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xdb, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a,
-	0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x1a, 0xf6, 0x01,
-	0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b,
-	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x63, 0x61,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x61, 0x72,
-	0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x1a, 0xf6, 0x01, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b,
-	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55,
-	0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x0a, 0x0a, 0x08, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x32, 0x80, 0x02, 0x0a, 0x06, 0x43, 0x61, 0x72, 0x64,
-	0x56, 0x31, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79,
-	0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x52, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x07, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x09,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x48, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x22, 0x1b, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x83,
+	0x03, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x1a, 0xf6, 0x01, 0x0a, 0x04,
+	0x43, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x69, 0x6e, 0x6b, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x04, 0x63, 0x61, 0x72, 0x64, 0x1a, 0xf6, 0x01, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x52,
+	0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x52, 0x4c,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4f,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x32,
+	0x86, 0x02, 0x0a, 0x06, 0x43, 0x61, 0x72, 0x64, 0x56, 0x31, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79,
+	0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
 	0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42,
-	0x79, 0x49, 0x44, 0x12, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x70, 0x69,
-	0x63, 0x6b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x70, 0x69, 0x63, 0x6b,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -740,38 +910,44 @@ func file_card_proto_rawDescGZIP() []byte {
 	return file_card_proto_rawDescData
 }
 
-var file_card_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_card_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_card_proto_goTypes = []interface{}{
 	(*Reply)(nil),            // 0: card.v1.Reply
 	(*Error)(nil),            // 1: card.v1.Error
-	(*CardsByBankIDReq)(nil), // 2: card.v1.CardsByBankIDReq
-	(*CardIDReq)(nil),        // 3: card.v1.CardIDReq
-	(*CardsReply)(nil),       // 4: card.v1.CardsReply
-	(*CardReply)(nil),        // 5: card.v1.CardReply
-	(*SearchCardReq)(nil),    // 6: card.v1.SearchCardReq
-	(*EmptyReq)(nil),         // 7: card.v1.EmptyReq
-	(*CardsReply_Card)(nil),  // 8: card.v1.CardsReply.Card
-	(*CardReply_Card)(nil),   // 9: card.v1.CardReply.Card
+	(*PageReq)(nil),          // 2: card.v1.PageReq
+	(*PageReply)(nil),        // 3: card.v1.PageReply
+	(*CardsByBankIDReq)(nil), // 4: card.v1.CardsByBankIDReq
+	(*LatestCardsReq)(nil),   // 5: card.v1.LatestCardsReq
+	(*CardIDReq)(nil),        // 6: card.v1.CardIDReq
+	(*CardsReply)(nil),       // 7: card.v1.CardsReply
+	(*CardReply)(nil),        // 8: card.v1.CardReply
+	(*SearchCardReq)(nil),    // 9: card.v1.SearchCardReq
+	(*CardsReply_Card)(nil),  // 10: card.v1.CardsReply.Card
+	(*CardReply_Card)(nil),   // 11: card.v1.CardReply.Card
 }
 var file_card_proto_depIdxs = []int32{
-	1, // 0: card.v1.Reply.error:type_name -> card.v1.Error
-	0, // 1: card.v1.CardsReply.reply:type_name -> card.v1.Reply
-	8, // 2: card.v1.CardsReply.cards:type_name -> card.v1.CardsReply.Card
-	0, // 3: card.v1.CardReply.reply:type_name -> card.v1.Reply
-	9, // 4: card.v1.CardReply.card:type_name -> card.v1.CardReply.Card
-	2, // 5: card.v1.CardV1.GetCardsByBankID:input_type -> card.v1.CardsByBankIDReq
-	7, // 6: card.v1.CardV1.GetLatestCards:input_type -> card.v1.EmptyReq
-	3, // 7: card.v1.CardV1.GetCardByID:input_type -> card.v1.CardIDReq
-	6, // 8: card.v1.CardV1.SearchCard:input_type -> card.v1.SearchCardReq
-	4, // 9: card.v1.CardV1.GetCardsByBankID:output_type -> card.v1.CardsReply
-	4, // 10: card.v1.CardV1.GetLatestCards:output_type -> card.v1.CardsReply
-	5, // 11: card.v1.CardV1.GetCardByID:output_type -> card.v1.CardReply
-	4, // 12: card.v1.CardV1.SearchCard:output_type -> card.v1.CardsReply
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: card.v1.Reply.error:type_name -> card.v1.Error
+	2,  // 1: card.v1.CardsByBankIDReq.page:type_name -> card.v1.PageReq
+	2,  // 2: card.v1.LatestCardsReq.page:type_name -> card.v1.PageReq
+	0,  // 3: card.v1.CardsReply.reply:type_name -> card.v1.Reply
+	10, // 4: card.v1.CardsReply.cards:type_name -> card.v1.CardsReply.Card
+	3,  // 5: card.v1.CardsReply.page:type_name -> card.v1.PageReply
+	0,  // 6: card.v1.CardReply.reply:type_name -> card.v1.Reply
+	11, // 7: card.v1.CardReply.card:type_name -> card.v1.CardReply.Card
+	2,  // 8: card.v1.SearchCardReq.page:type_name -> card.v1.PageReq
+	4,  // 9: card.v1.CardV1.GetCardsByBankID:input_type -> card.v1.CardsByBankIDReq
+	5,  // 10: card.v1.CardV1.GetLatestCards:input_type -> card.v1.LatestCardsReq
+	6,  // 11: card.v1.CardV1.GetCardByID:input_type -> card.v1.CardIDReq
+	9,  // 12: card.v1.CardV1.SearchCard:input_type -> card.v1.SearchCardReq
+	7,  // 13: card.v1.CardV1.GetCardsByBankID:output_type -> card.v1.CardsReply
+	7,  // 14: card.v1.CardV1.GetLatestCards:output_type -> card.v1.CardsReply
+	8,  // 15: card.v1.CardV1.GetCardByID:output_type -> card.v1.CardReply
+	7,  // 16: card.v1.CardV1.SearchCard:output_type -> card.v1.CardsReply
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_card_proto_init() }
@@ -805,7 +981,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardsByBankIDReq); i {
+			switch v := v.(*PageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -817,7 +993,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardIDReq); i {
+			switch v := v.(*PageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -829,7 +1005,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardsReply); i {
+			switch v := v.(*CardsByBankIDReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -841,7 +1017,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardReply); i {
+			switch v := v.(*LatestCardsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -853,7 +1029,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchCardReq); i {
+			switch v := v.(*CardIDReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -865,7 +1041,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyReq); i {
+			switch v := v.(*CardsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -877,7 +1053,7 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardsReply_Card); i {
+			switch v := v.(*CardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -889,6 +1065,30 @@ func file_card_proto_init() {
 			}
 		}
 		file_card_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCardReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardsReply_Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CardReply_Card); i {
 			case 0:
 				return &v.state
@@ -907,7 +1107,7 @@ func file_card_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_card_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
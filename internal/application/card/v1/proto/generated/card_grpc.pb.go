@@ -23,7 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CardV1Client interface {
 	GetCardsByBankID(ctx context.Context, in *CardsByBankIDReq, opts ...grpc.CallOption) (*CardsReply, error)
-	GetLatestCards(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (*CardsReply, error)
+	GetLatestCards(ctx context.Context, in *LatestCardsReq, opts ...grpc.CallOption) (*CardsReply, error)
 	GetCardByID(ctx context.Context, in *CardIDReq, opts ...grpc.CallOption) (*CardReply, error)
 	SearchCard(ctx context.Context, in *SearchCardReq, opts ...grpc.CallOption) (*CardsReply, error)
 }
@@ -45,7 +45,7 @@ func (c *cardV1Client) GetCardsByBankID(ctx context.Context, in *CardsByBankIDRe
 	return out, nil
 }
 
-func (c *cardV1Client) GetLatestCards(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (*CardsReply, error) {
+func (c *cardV1Client) GetLatestCards(ctx context.Context, in *LatestCardsReq, opts ...grpc.CallOption) (*CardsReply, error) {
 	out := new(CardsReply)
 	err := c.cc.Invoke(ctx, "/card.v1.CardV1/GetLatestCards", in, out, opts...)
 	if err != nil {
@@ -77,7 +77,7 @@ func (c *cardV1Client) SearchCard(ctx context.Context, in *SearchCardReq, opts .
 // for forward compatibility
 type CardV1Server interface {
 	GetCardsByBankID(context.Context, *CardsByBankIDReq) (*CardsReply, error)
-	GetLatestCards(context.Context, *EmptyReq) (*CardsReply, error)
+	GetLatestCards(context.Context, *LatestCardsReq) (*CardsReply, error)
 	GetCardByID(context.Context, *CardIDReq) (*CardReply, error)
 	SearchCard(context.Context, *SearchCardReq) (*CardsReply, error)
 	mustEmbedUnimplementedCardV1Server()
@@ -90,7 +90,7 @@ type UnimplementedCardV1Server struct {
 func (UnimplementedCardV1Server) GetCardsByBankID(context.Context, *CardsByBankIDReq) (*CardsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCardsByBankID not implemented")
 }
-func (UnimplementedCardV1Server) GetLatestCards(context.Context, *EmptyReq) (*CardsReply, error) {
+func (UnimplementedCardV1Server) GetLatestCards(context.Context, *LatestCardsReq) (*CardsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestCards not implemented")
 }
 func (UnimplementedCardV1Server) GetCardByID(context.Context, *CardIDReq) (*CardReply, error) {
@@ -131,7 +131,7 @@ func _CardV1_GetCardsByBankID_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _CardV1_GetLatestCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyReq)
+	in := new(LatestCardsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -143,7 +143,7 @@ func _CardV1_GetLatestCards_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: "/card.v1.CardV1/GetLatestCards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CardV1Server).GetLatestCards(ctx, req.(*EmptyReq))
+		return srv.(CardV1Server).GetLatestCards(ctx, req.(*LatestCardsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
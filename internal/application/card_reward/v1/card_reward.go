@@ -0,0 +1,210 @@
+package application
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+	"google.golang.org/grpc"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	pb "pickrewardapi/internal/application/card_reward/v1/proto/generated"
+
+	handler "pickrewardapi/internal/application/card_reward/v1/handler"
+
+	cardRewardService "pickrewardapi/internal/domain/card_reward/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type server struct {
+	dig.In
+
+	pb.UnimplementedCardRewardV1Server
+
+	cardRewardService cardRewardService.RewardAppService
+}
+
+func NewCardRewardServer(
+	s *grpc.Server,
+
+	cardRewardService cardRewardService.RewardAppService,
+) {
+	log.WithFields(log.Fields{
+		"pos": "[card_reward.api][NewCardRewardServer]",
+	}).Info("Init")
+
+	pb.RegisterCardRewardV1Server(s, &server{
+		cardRewardService: cardRewardService,
+	})
+}
+
+func (s *server) GetRewardsByCardID(ctx context.Context, in *pb.CardIDReq) (*pb.RewardsReply, error) {
+	logPos := "[card_reward.api][GetRewardsByCardID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.api.GetRewardsByCardID")
+	defer span.End()
+
+	rewardDTOs, pageInfo, err := s.cardRewardService.GetRewardsByCardID(ctx, in.CardID, in.IncludeOutOfWindow, handler.TransferPageReq(in.GetPage()))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.GetRewardsByCardID failed: ", err)
+
+		return &pb.RewardsReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetRewardsByCardID failed",
+				},
+			},
+		}, err
+	}
+
+	rewards := handler.TransferRewardDTOs2RewardsReply(rewardDTOs)
+
+	return &pb.RewardsReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Rewards: rewards,
+		Page:    handler.TransferPageInfo(pageInfo),
+	}, nil
+}
+
+func (s *server) GetActiveRewardsByCardID(ctx context.Context, in *pb.ActiveRewardsReq) (*pb.RewardsReply, error) {
+	logPos := "[card_reward.api][GetActiveRewardsByCardID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.api.GetActiveRewardsByCardID")
+	defer span.End()
+
+	rewardDTOs, pageInfo, err := s.cardRewardService.GetActiveRewardsByCardID(ctx, in.CardID, in.Date, handler.TransferPageReq(in.GetPage()))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.GetActiveRewardsByCardID failed: ", err)
+
+		return &pb.RewardsReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetActiveRewardsByCardID failed",
+				},
+			},
+		}, err
+	}
+
+	rewards := handler.TransferRewardDTOs2RewardsReply(rewardDTOs)
+
+	return &pb.RewardsReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Rewards: rewards,
+		Page:    handler.TransferPageInfo(pageInfo),
+	}, nil
+}
+
+func (s *server) GetRewardByID(ctx context.Context, in *pb.RewardIDReq) (*pb.RewardReply, error) {
+	logPos := "[card_reward.api][GetRewardByID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.api.GetRewardByID")
+	defer span.End()
+
+	rewardDTO, err := s.cardRewardService.GetRewardByID(ctx, in.Id)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.GetRewardByID failed: ", err)
+
+		return &pb.RewardReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetRewardByID failed",
+				},
+			},
+		}, err
+	}
+
+	reward := handler.TransferRewardDTO2RewardReply(rewardDTO)
+
+	return &pb.RewardReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Reward: reward,
+	}, nil
+}
+
+func (s *server) GetExpiringRewards(ctx context.Context, in *pb.RewardWindowReq) (*pb.BankRewardsReply, error) {
+	logPos := "[card_reward.api][GetExpiringRewards]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.api.GetExpiringRewards")
+	defer span.End()
+
+	bankRewardsDTOs, err := s.cardRewardService.GetExpiringRewards(ctx, in.Date, in.Days)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.GetExpiringRewards failed: ", err)
+
+		return &pb.BankRewardsReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetExpiringRewards failed",
+				},
+			},
+		}, err
+	}
+
+	banks := handler.TransferBankRewardsDTOs2BankRewardsReply(bankRewardsDTOs)
+
+	return &pb.BankRewardsReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Banks: banks,
+	}, nil
+}
+
+func (s *server) GetUpcomingRewards(ctx context.Context, in *pb.RewardWindowReq) (*pb.BankRewardsReply, error) {
+	logPos := "[card_reward.api][GetUpcomingRewards]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.api.GetUpcomingRewards")
+	defer span.End()
+
+	bankRewardsDTOs, err := s.cardRewardService.GetUpcomingRewards(ctx, in.Date, in.Days)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardRewardService.GetUpcomingRewards failed: ", err)
+
+		return &pb.BankRewardsReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetUpcomingRewards failed",
+				},
+			},
+		}, err
+	}
+
+	banks := handler.TransferBankRewardsDTOs2BankRewardsReply(bankRewardsDTOs)
+
+	return &pb.BankRewardsReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Banks: banks,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	pb "pickrewardapi/internal/application/card_reward/v1/proto/generated"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	"pickrewardapi/internal/shared/common/page"
+)
+
+func TransferRewardDTOs2RewardsReply(rewardDTOs []*rewardDTO.RewardDTO) []*pb.RewardsReply_Reward {
+
+	rewards := []*pb.RewardsReply_Reward{}
+
+	for _, r := range rewardDTOs {
+		rewards = append(rewards, &pb.RewardsReply_Reward{
+			Id:           r.ID,
+			CardID:       r.CardID,
+			Name:         r.Name,
+			Description:  string(r.Description),
+			StartDate:    r.StartDate,
+			EndDate:      r.EndDate,
+			Currency:     r.Currency,
+			RewardType:   r.RewardType,
+			Order:        r.Order,
+			Rule:         TransferRuleDTO2Rule(r.Rule),
+			CreateDate:   r.CreateDate,
+			UpdateDate:   r.UpdateDate,
+			RewardStatus: int32(r.RewardStatus),
+		})
+	}
+
+	return rewards
+}
+
+func TransferRewardDTO2RewardReply(r *rewardDTO.RewardDTO) *pb.RewardReply_Reward {
+
+	return &pb.RewardReply_Reward{
+		Id:           r.ID,
+		CardID:       r.CardID,
+		Name:         r.Name,
+		Description:  string(r.Description),
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		Currency:     r.Currency,
+		RewardType:   r.RewardType,
+		Order:        r.Order,
+		Rule:         TransferRuleDTO2Rule(r.Rule),
+		CreateDate:   r.CreateDate,
+		UpdateDate:   r.UpdateDate,
+		RewardStatus: int32(r.RewardStatus),
+	}
+}
+
+func TransferBankRewardsDTOs2BankRewardsReply(bankRewardsDTOs []*rewardDTO.BankRewardsDTO) []*pb.BankRewardsReply_Bank {
+
+	banks := []*pb.BankRewardsReply_Bank{}
+
+	for _, b := range bankRewardsDTOs {
+		cards := []*pb.BankRewardsReply_Card{}
+		for _, c := range b.Cards {
+			rewards := []*pb.BankRewardsReply_Reward{}
+			for _, r := range c.Rewards {
+				rewards = append(rewards, &pb.BankRewardsReply_Reward{
+					Id:           r.ID,
+					CardID:       r.CardID,
+					Name:         r.Name,
+					Description:  string(r.Description),
+					StartDate:    r.StartDate,
+					EndDate:      r.EndDate,
+					Currency:     r.Currency,
+					RewardType:   r.RewardType,
+					Order:        r.Order,
+					Rule:         TransferRuleDTO2Rule(r.Rule),
+					CreateDate:   r.CreateDate,
+					UpdateDate:   r.UpdateDate,
+					RewardStatus: int32(r.RewardStatus),
+				})
+			}
+
+			cards = append(cards, &pb.BankRewardsReply_Card{
+				CardID:   c.CardID,
+				CardName: c.CardName,
+				Rewards:  rewards,
+			})
+		}
+
+		banks = append(banks, &pb.BankRewardsReply_Bank{
+			BankID:   b.BankID,
+			BankName: b.BankName,
+			Cards:    cards,
+		})
+	}
+
+	return banks
+}
+
+func TransferRuleDTO2Rule(ruleDTO *rewardDTO.RewardRuleDTO) *pb.Rule {
+
+	if ruleDTO == nil {
+		return nil
+	}
+
+	constraints := []*pb.Rule_Constraint{}
+	for _, c := range ruleDTO.Constraints {
+		constraints = append(constraints, &pb.Rule_Constraint{
+			ConstraintType: int32(c.ConstraintType),
+			ConstraintName: c.ConstraintName,
+			WeekDays:       c.WeekDays,
+			LimitCount:     c.LimitCount,
+		})
+	}
+
+	return &pb.Rule{
+		CalculateType:  int32(ruleDTO.CalculateType),
+		Percentage:     ruleDTO.Percentage,
+		Fixed:          ruleDTO.Fixed,
+		MinCost:        ruleDTO.MinCost,
+		PeriodMinCost:  ruleDTO.PeriodMinCost,
+		TransactionCap: ruleDTO.TransactionCap,
+		PeriodCap:      ruleDTO.PeriodCap,
+		PeriodType:     int32(ruleDTO.PeriodType),
+		ChannelIDs:     ruleDTO.ChannelIDs,
+		ChannelLabels:  ruleDTO.ChannelLabels,
+		PayIDs:         ruleDTO.PayIDs,
+		Constraints:    constraints,
+	}
+}
+
+func TransferPageReq(p *pb.PageReq) page.Request {
+	return page.Request{
+		Size:  p.GetPageSize(),
+		Token: p.GetPageToken(),
+	}
+}
+
+func TransferPageInfo(info *page.Info) *pb.PageReply {
+	return &pb.PageReply{
+		NextPageToken: info.NextToken,
+		TotalCount:    info.Total,
+	}
+}
@@ -0,0 +1,1950 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: card_reward.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reply is the status of a call. A failed call returns a gRPC status error
+// with an ErrorInfo detail, clients sending the metadata x-error-mode: reply
+// get the reply with status 1 and error set and no gRPC error instead.
+type Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  *Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Reply) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode    int32  `protobuf:"varint,1,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Error) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *Error) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// PageReq asks for a page of a list. pageSize defaults to 20 and is capped
+// at 100, pageToken is the nextPageToken of the previous page.
+type PageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *PageReq) Reset() {
+	*x = PageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReq) ProtoMessage() {}
+
+func (x *PageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReq.ProtoReflect.Descriptor instead.
+func (*PageReq) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PageReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// PageReply has an empty nextPageToken on the last page, totalCount counts
+// the items of all pages.
+type PageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string `protobuf:"bytes,1,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int32  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+}
+
+func (x *PageReply) Reset() {
+	*x = PageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageReply) ProtoMessage() {}
+
+func (x *PageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageReply.ProtoReflect.Descriptor instead.
+func (*PageReply) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PageReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CardIDReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID             string   `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	IncludeOutOfWindow bool     `protobuf:"varint,2,opt,name=includeOutOfWindow,proto3" json:"includeOutOfWindow,omitempty"`
+	Page               *PageReq `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *CardIDReq) Reset() {
+	*x = CardIDReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardIDReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardIDReq) ProtoMessage() {}
+
+func (x *CardIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardIDReq.ProtoReflect.Descriptor instead.
+func (*CardIDReq) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CardIDReq) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *CardIDReq) GetIncludeOutOfWindow() bool {
+	if x != nil {
+		return x.IncludeOutOfWindow
+	}
+	return false
+}
+
+func (x *CardIDReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ActiveRewardsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID string   `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Date   int64    `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	Page   *PageReq `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ActiveRewardsReq) Reset() {
+	*x = ActiveRewardsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveRewardsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveRewardsReq) ProtoMessage() {}
+
+func (x *ActiveRewardsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveRewardsReq.ProtoReflect.Descriptor instead.
+func (*ActiveRewardsReq) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActiveRewardsReq) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *ActiveRewardsReq) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *ActiveRewardsReq) GetPage() *PageReq {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type RewardIDReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RewardIDReq) Reset() {
+	*x = RewardIDReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardIDReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardIDReq) ProtoMessage() {}
+
+func (x *RewardIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardIDReq.ProtoReflect.Descriptor instead.
+func (*RewardIDReq) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RewardIDReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RewardWindowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Date int64 `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *RewardWindowReq) Reset() {
+	*x = RewardWindowReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardWindowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardWindowReq) ProtoMessage() {}
+
+func (x *RewardWindowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardWindowReq.ProtoReflect.Descriptor instead.
+func (*RewardWindowReq) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RewardWindowReq) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *RewardWindowReq) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalculateType  int32              `protobuf:"varint,1,opt,name=calculateType,proto3" json:"calculateType,omitempty"`
+	Percentage     float64            `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Fixed          float64            `protobuf:"fixed64,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	MinCost        int32              `protobuf:"varint,4,opt,name=minCost,proto3" json:"minCost,omitempty"`
+	PeriodMinCost  int32              `protobuf:"varint,5,opt,name=periodMinCost,proto3" json:"periodMinCost,omitempty"`
+	TransactionCap float64            `protobuf:"fixed64,6,opt,name=transactionCap,proto3" json:"transactionCap,omitempty"`
+	PeriodCap      float64            `protobuf:"fixed64,7,opt,name=periodCap,proto3" json:"periodCap,omitempty"`
+	PeriodType     int32              `protobuf:"varint,8,opt,name=periodType,proto3" json:"periodType,omitempty"`
+	ChannelIDs     []string           `protobuf:"bytes,9,rep,name=channelIDs,proto3" json:"channelIDs,omitempty"`
+	ChannelLabels  []int32            `protobuf:"varint,10,rep,packed,name=channelLabels,proto3" json:"channelLabels,omitempty"`
+	PayIDs         []string           `protobuf:"bytes,11,rep,name=payIDs,proto3" json:"payIDs,omitempty"`
+	Constraints    []*Rule_Constraint `protobuf:"bytes,12,rep,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Rule) GetCalculateType() int32 {
+	if x != nil {
+		return x.CalculateType
+	}
+	return 0
+}
+
+func (x *Rule) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Rule) GetFixed() float64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *Rule) GetMinCost() int32 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *Rule) GetPeriodMinCost() int32 {
+	if x != nil {
+		return x.PeriodMinCost
+	}
+	return 0
+}
+
+func (x *Rule) GetTransactionCap() float64 {
+	if x != nil {
+		return x.TransactionCap
+	}
+	return 0
+}
+
+func (x *Rule) GetPeriodCap() float64 {
+	if x != nil {
+		return x.PeriodCap
+	}
+	return 0
+}
+
+func (x *Rule) GetPeriodType() int32 {
+	if x != nil {
+		return x.PeriodType
+	}
+	return 0
+}
+
+func (x *Rule) GetChannelIDs() []string {
+	if x != nil {
+		return x.ChannelIDs
+	}
+	return nil
+}
+
+func (x *Rule) GetChannelLabels() []int32 {
+	if x != nil {
+		return x.ChannelLabels
+	}
+	return nil
+}
+
+func (x *Rule) GetPayIDs() []string {
+	if x != nil {
+		return x.PayIDs
+	}
+	return nil
+}
+
+func (x *Rule) GetConstraints() []*Rule_Constraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type RewardsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply   *Reply                 `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Rewards []*RewardsReply_Reward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Page    *PageReply             `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *RewardsReply) Reset() {
+	*x = RewardsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardsReply) ProtoMessage() {}
+
+func (x *RewardsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardsReply.ProtoReflect.Descriptor instead.
+func (*RewardsReply) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RewardsReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *RewardsReply) GetRewards() []*RewardsReply_Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *RewardsReply) GetPage() *PageReply {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type RewardReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply  *Reply              `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reward *RewardReply_Reward `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *RewardReply) Reset() {
+	*x = RewardReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReply) ProtoMessage() {}
+
+func (x *RewardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReply.ProtoReflect.Descriptor instead.
+func (*RewardReply) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RewardReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *RewardReply) GetReward() *RewardReply_Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+type BankRewardsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply *Reply                   `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Banks []*BankRewardsReply_Bank `protobuf:"bytes,2,rep,name=banks,proto3" json:"banks,omitempty"`
+}
+
+func (x *BankRewardsReply) Reset() {
+	*x = BankRewardsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankRewardsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankRewardsReply) ProtoMessage() {}
+
+func (x *BankRewardsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankRewardsReply.ProtoReflect.Descriptor instead.
+func (*BankRewardsReply) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BankRewardsReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *BankRewardsReply) GetBanks() []*BankRewardsReply_Bank {
+	if x != nil {
+		return x.Banks
+	}
+	return nil
+}
+
+type Rule_Constraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConstraintType int32   `protobuf:"varint,1,opt,name=constraintType,proto3" json:"constraintType,omitempty"`
+	ConstraintName string  `protobuf:"bytes,2,opt,name=constraintName,proto3" json:"constraintName,omitempty"`
+	WeekDays       []int32 `protobuf:"varint,3,rep,packed,name=weekDays,proto3" json:"weekDays,omitempty"`
+	LimitCount     int32   `protobuf:"varint,4,opt,name=limitCount,proto3" json:"limitCount,omitempty"`
+}
+
+func (x *Rule_Constraint) Reset() {
+	*x = Rule_Constraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_Constraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_Constraint) ProtoMessage() {}
+
+func (x *Rule_Constraint) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_Constraint.ProtoReflect.Descriptor instead.
+func (*Rule_Constraint) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Rule_Constraint) GetConstraintType() int32 {
+	if x != nil {
+		return x.ConstraintType
+	}
+	return 0
+}
+
+func (x *Rule_Constraint) GetConstraintName() string {
+	if x != nil {
+		return x.ConstraintName
+	}
+	return ""
+}
+
+func (x *Rule_Constraint) GetWeekDays() []int32 {
+	if x != nil {
+		return x.WeekDays
+	}
+	return nil
+}
+
+func (x *Rule_Constraint) GetLimitCount() int32 {
+	if x != nil {
+		return x.LimitCount
+	}
+	return 0
+}
+
+type RewardsReply_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CardID       string `protobuf:"bytes,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate    int64  `protobuf:"varint,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate      int64  `protobuf:"varint,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Currency     int32  `protobuf:"varint,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	RewardType   int32  `protobuf:"varint,8,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Order        int32  `protobuf:"varint,9,opt,name=order,proto3" json:"order,omitempty"`
+	Rule         *Rule  `protobuf:"bytes,10,opt,name=rule,proto3" json:"rule,omitempty"`
+	CreateDate   int64  `protobuf:"varint,11,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	UpdateDate   int64  `protobuf:"varint,12,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	RewardStatus int32  `protobuf:"varint,13,opt,name=rewardStatus,proto3" json:"rewardStatus,omitempty"`
+}
+
+func (x *RewardsReply_Reward) Reset() {
+	*x = RewardsReply_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardsReply_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardsReply_Reward) ProtoMessage() {}
+
+func (x *RewardsReply_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardsReply_Reward.ProtoReflect.Descriptor instead.
+func (*RewardsReply_Reward) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *RewardsReply_Reward) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RewardsReply_Reward) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *RewardsReply_Reward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RewardsReply_Reward) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RewardsReply_Reward) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetCurrency() int32 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RewardsReply_Reward) GetCreateDate() int64 {
+	if x != nil {
+		return x.CreateDate
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetUpdateDate() int64 {
+	if x != nil {
+		return x.UpdateDate
+	}
+	return 0
+}
+
+func (x *RewardsReply_Reward) GetRewardStatus() int32 {
+	if x != nil {
+		return x.RewardStatus
+	}
+	return 0
+}
+
+type RewardReply_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CardID       string `protobuf:"bytes,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate    int64  `protobuf:"varint,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate      int64  `protobuf:"varint,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Currency     int32  `protobuf:"varint,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	RewardType   int32  `protobuf:"varint,8,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Order        int32  `protobuf:"varint,9,opt,name=order,proto3" json:"order,omitempty"`
+	Rule         *Rule  `protobuf:"bytes,10,opt,name=rule,proto3" json:"rule,omitempty"`
+	CreateDate   int64  `protobuf:"varint,11,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	UpdateDate   int64  `protobuf:"varint,12,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	RewardStatus int32  `protobuf:"varint,13,opt,name=rewardStatus,proto3" json:"rewardStatus,omitempty"`
+}
+
+func (x *RewardReply_Reward) Reset() {
+	*x = RewardReply_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardReply_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReply_Reward) ProtoMessage() {}
+
+func (x *RewardReply_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReply_Reward.ProtoReflect.Descriptor instead.
+func (*RewardReply_Reward) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *RewardReply_Reward) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RewardReply_Reward) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *RewardReply_Reward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RewardReply_Reward) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RewardReply_Reward) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetCurrency() int32 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RewardReply_Reward) GetCreateDate() int64 {
+	if x != nil {
+		return x.CreateDate
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetUpdateDate() int64 {
+	if x != nil {
+		return x.UpdateDate
+	}
+	return 0
+}
+
+func (x *RewardReply_Reward) GetRewardStatus() int32 {
+	if x != nil {
+		return x.RewardStatus
+	}
+	return 0
+}
+
+type BankRewardsReply_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CardID       string `protobuf:"bytes,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate    int64  `protobuf:"varint,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate      int64  `protobuf:"varint,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Currency     int32  `protobuf:"varint,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	RewardType   int32  `protobuf:"varint,8,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Order        int32  `protobuf:"varint,9,opt,name=order,proto3" json:"order,omitempty"`
+	Rule         *Rule  `protobuf:"bytes,10,opt,name=rule,proto3" json:"rule,omitempty"`
+	CreateDate   int64  `protobuf:"varint,11,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	UpdateDate   int64  `protobuf:"varint,12,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	RewardStatus int32  `protobuf:"varint,13,opt,name=rewardStatus,proto3" json:"rewardStatus,omitempty"`
+}
+
+func (x *BankRewardsReply_Reward) Reset() {
+	*x = BankRewardsReply_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankRewardsReply_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankRewardsReply_Reward) ProtoMessage() {}
+
+func (x *BankRewardsReply_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankRewardsReply_Reward.ProtoReflect.Descriptor instead.
+func (*BankRewardsReply_Reward) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *BankRewardsReply_Reward) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Reward) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Reward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Reward) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Reward) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetCurrency() int32 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *BankRewardsReply_Reward) GetCreateDate() int64 {
+	if x != nil {
+		return x.CreateDate
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetUpdateDate() int64 {
+	if x != nil {
+		return x.UpdateDate
+	}
+	return 0
+}
+
+func (x *BankRewardsReply_Reward) GetRewardStatus() int32 {
+	if x != nil {
+		return x.RewardStatus
+	}
+	return 0
+}
+
+type BankRewardsReply_Card struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID   string                     `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CardName string                     `protobuf:"bytes,2,opt,name=cardName,proto3" json:"cardName,omitempty"`
+	Rewards  []*BankRewardsReply_Reward `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *BankRewardsReply_Card) Reset() {
+	*x = BankRewardsReply_Card{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankRewardsReply_Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankRewardsReply_Card) ProtoMessage() {}
+
+func (x *BankRewardsReply_Card) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankRewardsReply_Card.ProtoReflect.Descriptor instead.
+func (*BankRewardsReply_Card) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *BankRewardsReply_Card) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Card) GetCardName() string {
+	if x != nil {
+		return x.CardName
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Card) GetRewards() []*BankRewardsReply_Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type BankRewardsReply_Bank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankID   string                   `protobuf:"bytes,1,opt,name=bankID,proto3" json:"bankID,omitempty"`
+	BankName string                   `protobuf:"bytes,2,opt,name=bankName,proto3" json:"bankName,omitempty"`
+	Cards    []*BankRewardsReply_Card `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
+}
+
+func (x *BankRewardsReply_Bank) Reset() {
+	*x = BankRewardsReply_Bank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_reward_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankRewardsReply_Bank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankRewardsReply_Bank) ProtoMessage() {}
+
+func (x *BankRewardsReply_Bank) ProtoReflect() protoreflect.Message {
+	mi := &file_card_reward_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankRewardsReply_Bank.ProtoReflect.Descriptor instead.
+func (*BankRewardsReply_Bank) Descriptor() ([]byte, []int) {
+	return file_card_reward_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *BankRewardsReply_Bank) GetBankID() string {
+	if x != nil {
+		return x.BankID
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Bank) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *BankRewardsReply_Bank) GetCards() []*BankRewardsReply_Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+var File_card_reward_proto protoreflect.FileDescriptor
+
+var file_card_reward_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x22, 0x4c, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x49, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x07,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x51, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f,
+	0x75, 0x74, 0x4f, 0x66, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xc4,
+	0x04, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x6e, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x49, 0x44, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x79, 0x49, 0x44, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x98, 0x01, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65,
+	0x6b, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77, 0x65, 0x65,
+	0x6b, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0x04, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x1a, 0xfe, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xf7, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3a, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x1a, 0xfe, 0x02, 0x0a, 0x06,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf5, 0x05, 0x0a,
+	0x10, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b,
+	0x0a, 0x05, 0x62, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x42, 0x61, 0x6e, 0x6b, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0xfe, 0x02, 0x0a, 0x06,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x7d, 0x0a, 0x04,
+	0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x77, 0x0a, 0x04, 0x42,
+	0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x61, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
+	0x61, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x32, 0xc0, 0x03, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x56, 0x31, 0x12, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x70, 0x69, 0x63, 0x6b, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_card_reward_proto_rawDescOnce sync.Once
+	file_card_reward_proto_rawDescData = file_card_reward_proto_rawDesc
+)
+
+func file_card_reward_proto_rawDescGZIP() []byte {
+	file_card_reward_proto_rawDescOnce.Do(func() {
+		file_card_reward_proto_rawDescData = protoimpl.X.CompressGZIP(file_card_reward_proto_rawDescData)
+	})
+	return file_card_reward_proto_rawDescData
+}
+
+var file_card_reward_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_card_reward_proto_goTypes = []interface{}{
+	(*Reply)(nil),                   // 0: card_reward.v1.Reply
+	(*Error)(nil),                   // 1: card_reward.v1.Error
+	(*PageReq)(nil),                 // 2: card_reward.v1.PageReq
+	(*PageReply)(nil),               // 3: card_reward.v1.PageReply
+	(*CardIDReq)(nil),               // 4: card_reward.v1.CardIDReq
+	(*ActiveRewardsReq)(nil),        // 5: card_reward.v1.ActiveRewardsReq
+	(*RewardIDReq)(nil),             // 6: card_reward.v1.RewardIDReq
+	(*RewardWindowReq)(nil),         // 7: card_reward.v1.RewardWindowReq
+	(*Rule)(nil),                    // 8: card_reward.v1.Rule
+	(*RewardsReply)(nil),            // 9: card_reward.v1.RewardsReply
+	(*RewardReply)(nil),             // 10: card_reward.v1.RewardReply
+	(*BankRewardsReply)(nil),        // 11: card_reward.v1.BankRewardsReply
+	(*Rule_Constraint)(nil),         // 12: card_reward.v1.Rule.Constraint
+	(*RewardsReply_Reward)(nil),     // 13: card_reward.v1.RewardsReply.Reward
+	(*RewardReply_Reward)(nil),      // 14: card_reward.v1.RewardReply.Reward
+	(*BankRewardsReply_Reward)(nil), // 15: card_reward.v1.BankRewardsReply.Reward
+	(*BankRewardsReply_Card)(nil),   // 16: card_reward.v1.BankRewardsReply.Card
+	(*BankRewardsReply_Bank)(nil),   // 17: card_reward.v1.BankRewardsReply.Bank
+}
+var file_card_reward_proto_depIdxs = []int32{
+	1,  // 0: card_reward.v1.Reply.error:type_name -> card_reward.v1.Error
+	2,  // 1: card_reward.v1.CardIDReq.page:type_name -> card_reward.v1.PageReq
+	2,  // 2: card_reward.v1.ActiveRewardsReq.page:type_name -> card_reward.v1.PageReq
+	12, // 3: card_reward.v1.Rule.constraints:type_name -> card_reward.v1.Rule.Constraint
+	0,  // 4: card_reward.v1.RewardsReply.reply:type_name -> card_reward.v1.Reply
+	13, // 5: card_reward.v1.RewardsReply.rewards:type_name -> card_reward.v1.RewardsReply.Reward
+	3,  // 6: card_reward.v1.RewardsReply.page:type_name -> card_reward.v1.PageReply
+	0,  // 7: card_reward.v1.RewardReply.reply:type_name -> card_reward.v1.Reply
+	14, // 8: card_reward.v1.RewardReply.reward:type_name -> card_reward.v1.RewardReply.Reward
+	0,  // 9: card_reward.v1.BankRewardsReply.reply:type_name -> card_reward.v1.Reply
+	17, // 10: card_reward.v1.BankRewardsReply.banks:type_name -> card_reward.v1.BankRewardsReply.Bank
+	8,  // 11: card_reward.v1.RewardsReply.Reward.rule:type_name -> card_reward.v1.Rule
+	8,  // 12: card_reward.v1.RewardReply.Reward.rule:type_name -> card_reward.v1.Rule
+	8,  // 13: card_reward.v1.BankRewardsReply.Reward.rule:type_name -> card_reward.v1.Rule
+	15, // 14: card_reward.v1.BankRewardsReply.Card.rewards:type_name -> card_reward.v1.BankRewardsReply.Reward
+	16, // 15: card_reward.v1.BankRewardsReply.Bank.cards:type_name -> card_reward.v1.BankRewardsReply.Card
+	4,  // 16: card_reward.v1.CardRewardV1.GetRewardsByCardID:input_type -> card_reward.v1.CardIDReq
+	5,  // 17: card_reward.v1.CardRewardV1.GetActiveRewardsByCardID:input_type -> card_reward.v1.ActiveRewardsReq
+	6,  // 18: card_reward.v1.CardRewardV1.GetRewardByID:input_type -> card_reward.v1.RewardIDReq
+	7,  // 19: card_reward.v1.CardRewardV1.GetExpiringRewards:input_type -> card_reward.v1.RewardWindowReq
+	7,  // 20: card_reward.v1.CardRewardV1.GetUpcomingRewards:input_type -> card_reward.v1.RewardWindowReq
+	9,  // 21: card_reward.v1.CardRewardV1.GetRewardsByCardID:output_type -> card_reward.v1.RewardsReply
+	9,  // 22: card_reward.v1.CardRewardV1.GetActiveRewardsByCardID:output_type -> card_reward.v1.RewardsReply
+	10, // 23: card_reward.v1.CardRewardV1.GetRewardByID:output_type -> card_reward.v1.RewardReply
+	11, // 24: card_reward.v1.CardRewardV1.GetExpiringRewards:output_type -> card_reward.v1.BankRewardsReply
+	11, // 25: card_reward.v1.CardRewardV1.GetUpcomingRewards:output_type -> card_reward.v1.BankRewardsReply
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_card_reward_proto_init() }
+func file_card_reward_proto_init() {
+	if File_card_reward_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_card_reward_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveRewardsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardWindowReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankRewardsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_Constraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardsReply_Reward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardReply_Reward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankRewardsReply_Reward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankRewardsReply_Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_reward_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankRewardsReply_Bank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_card_reward_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_card_reward_proto_goTypes,
+		DependencyIndexes: file_card_reward_proto_depIdxs,
+		MessageInfos:      file_card_reward_proto_msgTypes,
+	}.Build()
+	File_card_reward_proto = out.File
+	file_card_reward_proto_rawDesc = nil
+	file_card_reward_proto_goTypes = nil
+	file_card_reward_proto_depIdxs = nil
+}
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: card_reward.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CardRewardV1Client is the client API for CardRewardV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CardRewardV1Client interface {
+	GetRewardsByCardID(ctx context.Context, in *CardIDReq, opts ...grpc.CallOption) (*RewardsReply, error)
+	GetActiveRewardsByCardID(ctx context.Context, in *ActiveRewardsReq, opts ...grpc.CallOption) (*RewardsReply, error)
+	GetRewardByID(ctx context.Context, in *RewardIDReq, opts ...grpc.CallOption) (*RewardReply, error)
+	GetExpiringRewards(ctx context.Context, in *RewardWindowReq, opts ...grpc.CallOption) (*BankRewardsReply, error)
+	GetUpcomingRewards(ctx context.Context, in *RewardWindowReq, opts ...grpc.CallOption) (*BankRewardsReply, error)
+}
+
+type cardRewardV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCardRewardV1Client(cc grpc.ClientConnInterface) CardRewardV1Client {
+	return &cardRewardV1Client{cc}
+}
+
+func (c *cardRewardV1Client) GetRewardsByCardID(ctx context.Context, in *CardIDReq, opts ...grpc.CallOption) (*RewardsReply, error) {
+	out := new(RewardsReply)
+	err := c.cc.Invoke(ctx, "/card_reward.v1.CardRewardV1/GetRewardsByCardID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardRewardV1Client) GetActiveRewardsByCardID(ctx context.Context, in *ActiveRewardsReq, opts ...grpc.CallOption) (*RewardsReply, error) {
+	out := new(RewardsReply)
+	err := c.cc.Invoke(ctx, "/card_reward.v1.CardRewardV1/GetActiveRewardsByCardID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardRewardV1Client) GetRewardByID(ctx context.Context, in *RewardIDReq, opts ...grpc.CallOption) (*RewardReply, error) {
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, "/card_reward.v1.CardRewardV1/GetRewardByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardRewardV1Client) GetExpiringRewards(ctx context.Context, in *RewardWindowReq, opts ...grpc.CallOption) (*BankRewardsReply, error) {
+	out := new(BankRewardsReply)
+	err := c.cc.Invoke(ctx, "/card_reward.v1.CardRewardV1/GetExpiringRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardRewardV1Client) GetUpcomingRewards(ctx context.Context, in *RewardWindowReq, opts ...grpc.CallOption) (*BankRewardsReply, error) {
+	out := new(BankRewardsReply)
+	err := c.cc.Invoke(ctx, "/card_reward.v1.CardRewardV1/GetUpcomingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CardRewardV1Server is the server API for CardRewardV1 service.
+// All implementations must embed UnimplementedCardRewardV1Server
+// for forward compatibility
+type CardRewardV1Server interface {
+	GetRewardsByCardID(context.Context, *CardIDReq) (*RewardsReply, error)
+	GetActiveRewardsByCardID(context.Context, *ActiveRewardsReq) (*RewardsReply, error)
+	GetRewardByID(context.Context, *RewardIDReq) (*RewardReply, error)
+	GetExpiringRewards(context.Context, *RewardWindowReq) (*BankRewardsReply, error)
+	GetUpcomingRewards(context.Context, *RewardWindowReq) (*BankRewardsReply, error)
+	mustEmbedUnimplementedCardRewardV1Server()
+}
+
+// UnimplementedCardRewardV1Server must be embedded to have forward compatible implementations.
+type UnimplementedCardRewardV1Server struct {
+}
+
+func (UnimplementedCardRewardV1Server) GetRewardsByCardID(context.Context, *CardIDReq) (*RewardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardsByCardID not implemented")
+}
+func (UnimplementedCardRewardV1Server) GetActiveRewardsByCardID(context.Context, *ActiveRewardsReq) (*RewardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveRewardsByCardID not implemented")
+}
+func (UnimplementedCardRewardV1Server) GetRewardByID(context.Context, *RewardIDReq) (*RewardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardByID not implemented")
+}
+func (UnimplementedCardRewardV1Server) GetExpiringRewards(context.Context, *RewardWindowReq) (*BankRewardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiringRewards not implemented")
+}
+func (UnimplementedCardRewardV1Server) GetUpcomingRewards(context.Context, *RewardWindowReq) (*BankRewardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingRewards not implemented")
+}
+func (UnimplementedCardRewardV1Server) mustEmbedUnimplementedCardRewardV1Server() {}
+
+// UnsafeCardRewardV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CardRewardV1Server will
+// result in compilation errors.
+type UnsafeCardRewardV1Server interface {
+	mustEmbedUnimplementedCardRewardV1Server()
+}
+
+func RegisterCardRewardV1Server(s grpc.ServiceRegistrar, srv CardRewardV1Server) {
+	s.RegisterService(&CardRewardV1_ServiceDesc, srv)
+}
+
+func _CardRewardV1_GetRewardsByCardID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardRewardV1Server).GetRewardsByCardID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/card_reward.v1.CardRewardV1/GetRewardsByCardID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardRewardV1Server).GetRewardsByCardID(ctx, req.(*CardIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardRewardV1_GetActiveRewardsByCardID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveRewardsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardRewardV1Server).GetActiveRewardsByCardID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/card_reward.v1.CardRewardV1/GetActiveRewardsByCardID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardRewardV1Server).GetActiveRewardsByCardID(ctx, req.(*ActiveRewardsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardRewardV1_GetRewardByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardRewardV1Server).GetRewardByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/card_reward.v1.CardRewardV1/GetRewardByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardRewardV1Server).GetRewardByID(ctx, req.(*RewardIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardRewardV1_GetExpiringRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardWindowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardRewardV1Server).GetExpiringRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/card_reward.v1.CardRewardV1/GetExpiringRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardRewardV1Server).GetExpiringRewards(ctx, req.(*RewardWindowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardRewardV1_GetUpcomingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardWindowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardRewardV1Server).GetUpcomingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/card_reward.v1.CardRewardV1/GetUpcomingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardRewardV1Server).GetUpcomingRewards(ctx, req.(*RewardWindowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CardRewardV1_ServiceDesc is the grpc.ServiceDesc for CardRewardV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CardRewardV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "card_reward.v1.CardRewardV1",
+	HandlerType: (*CardRewardV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRewardsByCardID",
+			Handler:    _CardRewardV1_GetRewardsByCardID_Handler,
+		},
+		{
+			MethodName: "GetActiveRewardsByCardID",
+			Handler:    _CardRewardV1_GetActiveRewardsByCardID_Handler,
+		},
+		{
+			MethodName: "GetRewardByID",
+			Handler:    _CardRewardV1_GetRewardByID_Handler,
+		},
+		{
+			MethodName: "GetExpiringRewards",
+			Handler:    _CardRewardV1_GetExpiringRewards_Handler,
+		},
+		{
+			MethodName: "GetUpcomingRewards",
+			Handler:    _CardRewardV1_GetUpcomingRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "card_reward.proto",
+}
@@ -2,17 +2,22 @@ package application
 
 import (
 	"context"
-	"encoding/json"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 	"google.golang.org/grpc"
 
+	baseCtx "pickrewardapi/base/ctx"
+
 	pb "pickrewardapi/internal/application/channel/v1/proto/generated"
 
 	handler "pickrewardapi/internal/application/channel/v1/handler"
 
 	channelService "pickrewardapi/internal/domain/channel/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type server struct {
@@ -32,29 +37,25 @@ func NewChannelServer(
 		"pos": "[channel.api][NewChannelServer]",
 	}).Info("Init")
 
-	pb.RegisterChannelV1Server(s, &server{
+	pb.RegisterChannelV1Server(s, NewChannelV1Server(channelService))
+}
+
+// NewChannelV1Server returns the ChannelV1 implementation registered by
+// NewChannelServer, the REST gateway calls it in process.
+func NewChannelV1Server(channelService channelService.ChannelService) pb.ChannelV1Server {
+	return &server{
 		channelService: channelService,
-	})
+	}
 }
 
 func (s *server) GetChannelTypes(ctx context.Context, in *pb.EmptyReq) (*pb.ChannelTypesReply, error) {
-	logPos := "[channel.api][GetChannelTypes]"
-
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+	ctx, span := tracing.Start(ctx, "channel.api.GetChannelTypes")
+	defer span.End()
 
 	channelTypeDTOs := s.channelService.GetChannelTypes(ctx)
 
 	channelTypes := handler.TransferChannelTypeDTO2ChannelTypeReply(channelTypeDTOs)
 
-	channelTypesLog, _ := json.Marshal(channelTypes)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(channelTypesLog),
-	}).Info("Response")
-
 	return &pb.ChannelTypesReply{
 		Reply: &pb.Reply{
 			Status: 0,
@@ -65,14 +66,13 @@ func (s *server) GetChannelTypes(ctx context.Context, in *pb.EmptyReq) (*pb.Chan
 
 func (s *server) GetChannelsByType(ctx context.Context, in *pb.ChannelTypeReq) (*pb.ChannelsReply, error) {
 	logPos := "[channel.api][GetChannelsByType]"
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
 
-	channelDTOs, err := s.channelService.GetChannelsByType(ctx, in.Ctype, in.Limit, in.Offset)
+	ctx, span := tracing.Start(ctx, "channel.api.GetChannelsByType")
+	defer span.End()
+
+	channelDTOs, pageInfo, err := s.channelService.GetChannelsByType(ctx, in.Ctype, handler.TransferChannelTypeReq2PageReq(in))
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("channelService.GetChannelsByType failed: ", err)
 
@@ -80,38 +80,53 @@ func (s *server) GetChannelsByType(ctx context.Context, in *pb.ChannelTypeReq) (
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetChannelsByType failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
-	channels := handler.TransferChannels2ChannelsReply(channelDTOs)
-	channelsLog, _ := json.Marshal(channels)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(channelsLog),
-	}).Info("Response")
+	var labelNames map[int32]string
+	if in.WithLabelNames {
+		labelNames, err = s.channelService.GetChannelLabelNames(ctx)
+		if err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("channelService.GetChannelLabelNames failed: ", err)
+
+			return &pb.ChannelsReply{
+				Reply: &pb.Reply{
+					Status: 1,
+					Error: &pb.Error{
+						ErrorCode:    errs.Code(err),
+						ErrorMessage: "GetChannelsByType failed",
+					},
+				},
+			}, err
+		}
+	}
+
+	channels := handler.TransferChannels2ChannelsReply(channelDTOs, labelNames)
 
 	return &pb.ChannelsReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Channels: channels,
+		Page:     handler.TransferPageInfo(pageInfo),
 	}, nil
 }
 
 func (s *server) GetsByChannelIDs(ctx context.Context, in *pb.ChannelIDsReq) (*pb.ChannelsReply, error) {
 	logPos := "[channel.api][GetsByChannelIDs]"
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
+
+	ctx, span := tracing.Start(ctx, "channel.api.GetsByChannelIDs")
+	defer span.End()
 
 	channelDTOs, err := s.channelService.GetsByChannelIDs(ctx, in.ChannelIDs)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("channelService.GetsByChannelIDs failed: ", err)
 
@@ -119,19 +134,34 @@ func (s *server) GetsByChannelIDs(ctx context.Context, in *pb.ChannelIDsReq) (*p
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "GetsByChannelIDs failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
-	channels := handler.TransferChannels2ChannelsReply(channelDTOs)
-	channelsLog, _ := json.Marshal(channels)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(channelsLog),
-	}).Info("Response")
+	var labelNames map[int32]string
+	if in.WithLabelNames {
+		labelNames, err = s.channelService.GetChannelLabelNames(ctx)
+		if err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("channelService.GetChannelLabelNames failed: ", err)
+
+			return &pb.ChannelsReply{
+				Reply: &pb.Reply{
+					Status: 1,
+					Error: &pb.Error{
+						ErrorCode:    errs.Code(err),
+						ErrorMessage: "GetsByChannelIDs failed",
+					},
+				},
+			}, err
+		}
+	}
+
+	channels := handler.TransferChannels2ChannelsReply(channelDTOs, labelNames)
 
 	return &pb.ChannelsReply{
 		Reply: &pb.Reply{
@@ -143,14 +173,13 @@ func (s *server) GetsByChannelIDs(ctx context.Context, in *pb.ChannelIDsReq) (*p
 
 func (s *server) SearchChannel(ctx context.Context, in *pb.SearchChannelReq) (*pb.SearchChannelsReply, error) {
 	logPos := "[channel.api][SearchChannel]"
-	log.WithFields(log.Fields{
-		"pos": logPos,
-		"req": in,
-	}).Info("Request")
 
-	channelDTOs, err := s.channelService.SearchChannel(ctx, in.Keyword)
+	ctx, span := tracing.Start(ctx, "channel.api.SearchChannel")
+	defer span.End()
+
+	channelDTOs, pageInfo, err := s.channelService.SearchChannel(ctx, in.Keyword, handler.TransferPageReq(in.GetPage()))
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("channelService.SearchChannel failed: ", err)
 
@@ -158,25 +187,41 @@ func (s *server) SearchChannel(ctx context.Context, in *pb.SearchChannelReq) (*p
 			Reply: &pb.Reply{
 				Status: 1,
 				Error: &pb.Error{
-					ErrorCode:    100,
+					ErrorCode:    errs.Code(err),
 					ErrorMessage: "SearchChannel failed",
 				},
 			},
-		}, nil
+		}, err
 	}
 
-	channels := handler.TransferSearchChannels2SearchChannelsReply(channelDTOs)
-	channelsLog, _ := json.Marshal(channels)
-	log.WithFields(log.Fields{
-		"pos":  logPos,
-		"resp": string(channelsLog),
-	}).Info("Response")
+	var labelNames map[int32]string
+	if in.WithLabelNames {
+		labelNames, err = s.channelService.GetChannelLabelNames(ctx)
+		if err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("channelService.GetChannelLabelNames failed: ", err)
+
+			return &pb.SearchChannelsReply{
+				Reply: &pb.Reply{
+					Status: 1,
+					Error: &pb.Error{
+						ErrorCode:    errs.Code(err),
+						ErrorMessage: "SearchChannel failed",
+					},
+				},
+			}, err
+		}
+	}
+
+	channels := handler.TransferSearchChannels2SearchChannelsReply(channelDTOs, labelNames)
 
 	return &pb.SearchChannelsReply{
 		Reply: &pb.Reply{
 			Status: 0,
 		},
 		Channels: channels,
+		Page:     handler.TransferPageInfo(pageInfo),
 	}, nil
 
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	pb "pickrewardapi/internal/application/channel/v1/proto/generated"
 	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	"pickrewardapi/internal/shared/common/page"
 )
 
 func TransferChannelTypeDTO2ChannelTypeReply(channelTypeDTOs []*channelDTO.ChannelTypeDTO) []*pb.ChannelTypesReply_ChannelType {
@@ -17,43 +18,86 @@ func TransferChannelTypeDTO2ChannelTypeReply(channelTypeDTOs []*channelDTO.Chann
 	return channelTypes
 }
 
-func TransferChannels2ChannelsReply(channelDTOs []*channelDTO.ChannelDTO) []*pb.ChannelsReply_Channel {
+func TransferChannels2ChannelsReply(channelDTOs []*channelDTO.ChannelDTO, labelNames map[int32]string) []*pb.ChannelsReply_Channel {
 	channels := []*pb.ChannelsReply_Channel{}
 
 	for _, c := range channelDTOs {
 
 		channels = append(channels, &pb.ChannelsReply_Channel{
-			Id:            c.ID,
-			Name:          c.Name,
-			LinkURL:       c.LinkURL,
-			ChannelType:   c.ChannelType,
-			CreateDate:    c.CreateDate,
-			UpdateDate:    c.UpdateDate,
-			ChannelLabels: c.ChannelLabels,
-			Order:         c.Order,
-			ChannelStatus: int32(c.ChannelStatus),
+			Id:                c.ID,
+			Name:              c.Name,
+			LinkURL:           c.LinkURL,
+			ChannelType:       c.ChannelType,
+			CreateDate:        c.CreateDate,
+			UpdateDate:        c.UpdateDate,
+			ChannelLabels:     c.ChannelLabels,
+			Order:             c.Order,
+			ChannelStatus:     int32(c.ChannelStatus),
+			ChannelLabelNames: transferChannelLabelNames(c.ChannelLabels, labelNames),
 		})
 	}
 	return channels
 }
 
-func TransferSearchChannels2SearchChannelsReply(channelDTOs []*channelDTO.ChannelDTO) []*pb.SearchChannelsReply_Channel {
+func TransferSearchChannels2SearchChannelsReply(channelDTOs []*channelDTO.ChannelDTO, labelNames map[int32]string) []*pb.SearchChannelsReply_Channel {
 	channels := []*pb.SearchChannelsReply_Channel{}
 
 	for _, c := range channelDTOs {
 
 		channels = append(channels, &pb.SearchChannelsReply_Channel{
-			Id:            c.ID,
-			Name:          c.Name,
-			LinkURL:       c.LinkURL,
-			ChannelType:   c.ChannelType,
-			CreateDate:    c.CreateDate,
-			UpdateDate:    c.UpdateDate,
-			ChannelLabels: c.ChannelLabels,
-			Order:         c.Order,
-			ChannelStatus: int32(c.ChannelStatus),
+			Id:                c.ID,
+			Name:              c.Name,
+			LinkURL:           c.LinkURL,
+			ChannelType:       c.ChannelType,
+			CreateDate:        c.CreateDate,
+			UpdateDate:        c.UpdateDate,
+			ChannelLabels:     c.ChannelLabels,
+			Order:             c.Order,
+			ChannelStatus:     int32(c.ChannelStatus),
+			ChannelLabelNames: transferChannelLabelNames(c.ChannelLabels, labelNames),
 		})
 	}
 
 	return channels
 }
+
+func transferChannelLabelNames(channelLabels []int32, labelNames map[int32]string) []*pb.ChannelLabel {
+	if labelNames == nil {
+		return nil
+	}
+
+	channelLabelNames := []*pb.ChannelLabel{}
+	for _, l := range channelLabels {
+		channelLabelNames = append(channelLabelNames, &pb.ChannelLabel{
+			Label: l,
+			Name:  labelNames[l],
+		})
+	}
+	return channelLabelNames
+}
+
+func TransferPageReq(p *pb.PageReq) page.Request {
+	return page.Request{
+		Size:  p.GetPageSize(),
+		Token: p.GetPageToken(),
+	}
+}
+
+// TransferChannelTypeReq2PageReq falls back to the deprecated limit and
+// offset of the clients not sending a page.
+func TransferChannelTypeReq2PageReq(in *pb.ChannelTypeReq) page.Request {
+	req := TransferPageReq(in.GetPage())
+	if req.Size == 0 {
+		req.Size = in.GetLimit()
+	}
+	req.Offset = in.GetOffset()
+
+	return req
+}
+
+func TransferPageInfo(info *page.Info) *pb.PageReply {
+	return &pb.PageReply{
+		NextPageToken: info.NextToken,
+		TotalCount:    info.Total,
+	}
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+	"google.golang.org/grpc"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	pb "pickrewardapi/internal/application/channel_label/v1/proto/generated"
+
+	handler "pickrewardapi/internal/application/channel_label/v1/handler"
+
+	channelLabelService "pickrewardapi/internal/domain/channel_label/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type server struct {
+	dig.In
+
+	pb.UnimplementedChannelLabelV1Server
+
+	channelLabelService channelLabelService.ChannelLabelAppService
+}
+
+func NewChannelLabelServer(
+	s *grpc.Server,
+
+	channelLabelService channelLabelService.ChannelLabelAppService,
+) {
+	log.WithFields(log.Fields{
+		"pos": "[channel_label.api][NewChannelLabelServer]",
+	}).Info("Init")
+
+	pb.RegisterChannelLabelV1Server(s, &server{
+		channelLabelService: channelLabelService,
+	})
+}
+
+func (s *server) GetShowChannelLabels(ctx context.Context, in *pb.ShowChannelLabelsReq) (*pb.ChannelLabelsReply, error) {
+	logPos := "[channel_label.api][GetShowChannelLabels]"
+
+	ctx, span := tracing.Start(ctx, "channel_label.api.GetShowChannelLabels")
+	defer span.End()
+
+	channelLabelDTOs, pageInfo, err := s.channelLabelService.GetShowChannelLabels(ctx, handler.TransferPageReq(in.GetPage()))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelLabelService.GetShowChannelLabels failed: ", err)
+
+		return &pb.ChannelLabelsReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetShowChannelLabels failed",
+				},
+			},
+		}, err
+	}
+
+	channelLabels := handler.TransferChannelLabelDTOs2ChannelLabelsReply(channelLabelDTOs)
+
+	return &pb.ChannelLabelsReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		ChannelLabels: channelLabels,
+		Page:          handler.TransferPageInfo(pageInfo),
+	}, nil
+}
+
+func (s *server) GetChannelLabelByLabel(ctx context.Context, in *pb.ChannelLabelReq) (*pb.ChannelLabelReply, error) {
+	logPos := "[channel_label.api][GetChannelLabelByLabel]"
+
+	ctx, span := tracing.Start(ctx, "channel_label.api.GetChannelLabelByLabel")
+	defer span.End()
+
+	channelLabelDTO, err := s.channelLabelService.GetChannelLabelByLabel(ctx, in.Label)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelLabelService.GetChannelLabelByLabel failed: ", err)
+
+		return &pb.ChannelLabelReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "GetChannelLabelByLabel failed",
+				},
+			},
+		}, err
+	}
+
+	channelLabel := handler.TransferChannelLabelDTO2ChannelLabelReply(channelLabelDTO)
+
+	return &pb.ChannelLabelReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		ChannelLabel: channelLabel,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	pb "pickrewardapi/internal/application/channel_label/v1/proto/generated"
+	channelLabelDTO "pickrewardapi/internal/domain/channel_label/dto"
+	"pickrewardapi/internal/shared/common/page"
+)
+
+func TransferChannelLabelDTOs2ChannelLabelsReply(channelLabelDTOs []*channelLabelDTO.ChannelLabelDTO) []*pb.ChannelLabelsReply_ChannelLabel {
+	channelLabels := []*pb.ChannelLabelsReply_ChannelLabel{}
+
+	for _, c := range channelLabelDTOs {
+		channelLabels = append(channelLabels, &pb.ChannelLabelsReply_ChannelLabel{
+			Label: c.Label,
+			Name:  c.Name,
+			Show:  c.Show,
+		})
+	}
+	return channelLabels
+}
+
+func TransferChannelLabelDTO2ChannelLabelReply(c *channelLabelDTO.ChannelLabelDTO) *pb.ChannelLabelReply_ChannelLabel {
+	return &pb.ChannelLabelReply_ChannelLabel{
+		Label: c.Label,
+		Name:  c.Name,
+		Show:  c.Show,
+	}
+}
+
+func TransferPageReq(p *pb.PageReq) page.Request {
+	return page.Request{
+		Size:  p.GetPageSize(),
+		Token: p.GetPageToken(),
+	}
+}
+
+func TransferPageInfo(info *page.Info) *pb.PageReply {
+	return &pb.PageReply{
+		NextPageToken: info.NextToken,
+		TotalCount:    info.Total,
+	}
+}
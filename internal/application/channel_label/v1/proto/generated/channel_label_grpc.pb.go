@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: channel_label.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ChannelLabelV1Client is the client API for ChannelLabelV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChannelLabelV1Client interface {
+	GetShowChannelLabels(ctx context.Context, in *ShowChannelLabelsReq, opts ...grpc.CallOption) (*ChannelLabelsReply, error)
+	GetChannelLabelByLabel(ctx context.Context, in *ChannelLabelReq, opts ...grpc.CallOption) (*ChannelLabelReply, error)
+}
+
+type channelLabelV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChannelLabelV1Client(cc grpc.ClientConnInterface) ChannelLabelV1Client {
+	return &channelLabelV1Client{cc}
+}
+
+func (c *channelLabelV1Client) GetShowChannelLabels(ctx context.Context, in *ShowChannelLabelsReq, opts ...grpc.CallOption) (*ChannelLabelsReply, error) {
+	out := new(ChannelLabelsReply)
+	err := c.cc.Invoke(ctx, "/channel_label.v1.ChannelLabelV1/GetShowChannelLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelLabelV1Client) GetChannelLabelByLabel(ctx context.Context, in *ChannelLabelReq, opts ...grpc.CallOption) (*ChannelLabelReply, error) {
+	out := new(ChannelLabelReply)
+	err := c.cc.Invoke(ctx, "/channel_label.v1.ChannelLabelV1/GetChannelLabelByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChannelLabelV1Server is the server API for ChannelLabelV1 service.
+// All implementations must embed UnimplementedChannelLabelV1Server
+// for forward compatibility
+type ChannelLabelV1Server interface {
+	GetShowChannelLabels(context.Context, *ShowChannelLabelsReq) (*ChannelLabelsReply, error)
+	GetChannelLabelByLabel(context.Context, *ChannelLabelReq) (*ChannelLabelReply, error)
+	mustEmbedUnimplementedChannelLabelV1Server()
+}
+
+// UnimplementedChannelLabelV1Server must be embedded to have forward compatible implementations.
+type UnimplementedChannelLabelV1Server struct {
+}
+
+func (UnimplementedChannelLabelV1Server) GetShowChannelLabels(context.Context, *ShowChannelLabelsReq) (*ChannelLabelsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShowChannelLabels not implemented")
+}
+func (UnimplementedChannelLabelV1Server) GetChannelLabelByLabel(context.Context, *ChannelLabelReq) (*ChannelLabelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelLabelByLabel not implemented")
+}
+func (UnimplementedChannelLabelV1Server) mustEmbedUnimplementedChannelLabelV1Server() {}
+
+// UnsafeChannelLabelV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChannelLabelV1Server will
+// result in compilation errors.
+type UnsafeChannelLabelV1Server interface {
+	mustEmbedUnimplementedChannelLabelV1Server()
+}
+
+func RegisterChannelLabelV1Server(s grpc.ServiceRegistrar, srv ChannelLabelV1Server) {
+	s.RegisterService(&ChannelLabelV1_ServiceDesc, srv)
+}
+
+func _ChannelLabelV1_GetShowChannelLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowChannelLabelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelLabelV1Server).GetShowChannelLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel_label.v1.ChannelLabelV1/GetShowChannelLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelLabelV1Server).GetShowChannelLabels(ctx, req.(*ShowChannelLabelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelLabelV1_GetChannelLabelByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelLabelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelLabelV1Server).GetChannelLabelByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel_label.v1.ChannelLabelV1/GetChannelLabelByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelLabelV1Server).GetChannelLabelByLabel(ctx, req.(*ChannelLabelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChannelLabelV1_ServiceDesc is the grpc.ServiceDesc for ChannelLabelV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChannelLabelV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "channel_label.v1.ChannelLabelV1",
+	HandlerType: (*ChannelLabelV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetShowChannelLabels",
+			Handler:    _ChannelLabelV1_GetShowChannelLabels_Handler,
+		},
+		{
+			MethodName: "GetChannelLabelByLabel",
+			Handler:    _ChannelLabelV1_GetChannelLabelByLabel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "channel_label.proto",
+}
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+	"google.golang.org/grpc"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	pb "pickrewardapi/internal/application/evaluation/v1/proto/generated"
+
+	handler "pickrewardapi/internal/application/evaluation/v1/handler"
+
+	evaluationService "pickrewardapi/internal/domain/evaluation/service"
+
+	"pickrewardapi/internal/shared/common/errs"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type server struct {
+	dig.In
+
+	pb.UnimplementedEvaluationV1Server
+
+	evaluationService evaluationService.EvaluationService
+}
+
+func NewEvaluationServer(
+	s *grpc.Server,
+
+	evaluationService evaluationService.EvaluationService,
+) {
+	log.WithFields(log.Fields{
+		"pos": "[evaluation.api][NewEvaluationServer]",
+	}).Info("Init")
+
+	pb.RegisterEvaluationV1Server(s, &server{
+		evaluationService: evaluationService,
+	})
+}
+
+func (s *server) EvaluateEvent(ctx context.Context, in *pb.EvaluateEventReq) (*pb.EvaluationReply, error) {
+	logPos := "[evaluation.api][EvaluateEvent]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.api.EvaluateEvent")
+	defer span.End()
+
+	event := handler.TransferEventReq2Event(in.Event)
+
+	evaluationDTO, err := s.evaluationService.EvaluateEvent(ctx, in.CardID, event)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("evaluationService.EvaluateEvent failed: ", err)
+
+		return &pb.EvaluationReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "EvaluateEvent failed",
+				},
+			},
+		}, err
+	}
+
+	evaluation := handler.TransferEvaluationDTO2EvaluationReply(evaluationDTO)
+
+	return &pb.EvaluationReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		Evaluation: evaluation,
+	}, nil
+}
+
+func (s *server) RankCards(ctx context.Context, in *pb.RankCardsReq) (*pb.CardRanksReply, error) {
+	logPos := "[evaluation.api][RankCards]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.api.RankCards")
+	defer span.End()
+
+	event := handler.TransferRankCardsReq2Event(in)
+
+	cardRankDTOs, err := s.evaluationService.RankCards(ctx, event)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("evaluationService.RankCards failed: ", err)
+
+		return &pb.CardRanksReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "RankCards failed",
+				},
+			},
+		}, err
+	}
+
+	cardRanks := handler.TransferCardRankDTOs2CardRanksReply(cardRankDTOs)
+
+	return &pb.CardRanksReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		CardRanks: cardRanks,
+	}, nil
+}
+
+func (s *server) SimulateEvents(ctx context.Context, in *pb.SimulateEventsReq) (*pb.SimulationReply, error) {
+	logPos := "[evaluation.api][SimulateEvents]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.api.SimulateEvents")
+	defer span.End()
+
+	events := handler.TransferEventsReq2Events(in.Events)
+
+	simulationDTO, err := s.evaluationService.SimulateEvents(ctx, events, in.CardIDs)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("evaluationService.SimulateEvents failed: ", err)
+
+		return &pb.SimulationReply{
+			Reply: &pb.Reply{
+				Status: 1,
+				Error: &pb.Error{
+					ErrorCode:    errs.Code(err),
+					ErrorMessage: "SimulateEvents failed",
+				},
+			},
+		}, err
+	}
+
+	eventSimulations, cardSimulations := handler.TransferSimulationDTO2SimulationReply(simulationDTO)
+
+	return &pb.SimulationReply{
+		Reply: &pb.Reply{
+			Status: 0,
+		},
+		EventSimulations: eventSimulations,
+		CardSimulations:  cardSimulations,
+	}, nil
+}
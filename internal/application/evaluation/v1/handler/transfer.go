@@ -0,0 +1,207 @@
+package handler
+
+import (
+	pb "pickrewardapi/internal/application/evaluation/v1/proto/generated"
+
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+func TransferEventReq2Event(in *pb.Event) *commonM.Event {
+
+	if in == nil {
+		return nil
+	}
+
+	event := &commonM.Event{
+		ID:   in.Id,
+		Date: in.Date,
+		Cost: in.Cost,
+	}
+
+	if in.ChannelEvent != nil {
+		channelIDs := []*commonM.ChannelIDEvent{}
+		for _, c := range in.ChannelEvent.ChannelIDs {
+			channelIDs = append(channelIDs, &commonM.ChannelIDEvent{
+				ChannelID:     c.ChannelID,
+				ChannelLabels: c.ChannelLabels,
+			})
+		}
+
+		event.ChannelEvent = &commonM.ChannelEvent{
+			ChannelIDs:    channelIDs,
+			ChannelLabels: in.ChannelEvent.ChannelLabels,
+		}
+	}
+
+	if in.PayEvent != nil {
+		event.PayEvent = &commonM.PayEvent{
+			Status: commonM.PayStatus(in.PayEvent.Status),
+			PayIDs: in.PayEvent.PayIDs,
+		}
+	}
+
+	if in.CardEvent != nil {
+		event.CardEvent = &commonM.CardEvent{
+			RewardType: in.CardEvent.RewardType,
+			TaskLabels: in.CardEvent.TaskLabels,
+		}
+	}
+
+	event.UserEvent = TransferUserEventReq2UserEvent(in.UserEvent)
+
+	return event
+}
+
+func TransferUserEventReq2UserEvent(in *pb.Event_UserEvent) *commonM.UserEvent {
+
+	if in == nil {
+		return nil
+	}
+
+	return &commonM.UserEvent{
+		NewCustomerCardIDs:  in.NewCustomerCardIDs,
+		RegisteredRewardIDs: in.RegisteredRewardIDs,
+	}
+}
+
+func TransferEvaluationDTO2EvaluationReply(evaluationDTO *evaluationDTO.EvaluationDTO) *pb.EvaluationReply_Evaluation {
+
+	rewardEvaluations := []*pb.EvaluationReply_RewardEvaluation{}
+	for _, r := range evaluationDTO.RewardEvaluations {
+
+		constraintEvaluations := []*pb.EvaluationReply_ConstraintEvaluation{}
+		for _, c := range r.ConstraintEvaluations {
+			constraintEvaluations = append(constraintEvaluations, &pb.EvaluationReply_ConstraintEvaluation{
+				ConstraintType:   c.ConstraintType,
+				ConstraintName:   c.ConstraintName,
+				ConstraintStatus: c.ConstraintStatus,
+			})
+		}
+
+		rewardEvaluations = append(rewardEvaluations, &pb.EvaluationReply_RewardEvaluation{
+			RewardID:             r.RewardID,
+			Name:                 r.Name,
+			RewardType:           r.RewardType,
+			Percentage:           r.Percentage,
+			Amount:               r.Amount,
+			MatchedChannelIDs:    r.MatchedChannelIDs,
+			MatchedChannelLabels: r.MatchedChannelLabels,
+			MatchedPayIDs:        r.MatchedPayIDs,
+			CalculateType:        r.CalculateType,
+			Fixed:                r.Fixed,
+			Capped:               r.Capped,
+
+			ConstraintStatus:      r.ConstraintStatus,
+			ConstraintEvaluations: constraintEvaluations,
+		})
+	}
+
+	return &pb.EvaluationReply_Evaluation{
+		CardID:            evaluationDTO.CardID,
+		EventID:           evaluationDTO.EventID,
+		Date:              evaluationDTO.Date,
+		Cost:              evaluationDTO.Cost,
+		Cash:              evaluationDTO.Cash,
+		Point:             evaluationDTO.Point,
+		RewardEvaluations: rewardEvaluations,
+	}
+}
+
+func TransferRankCardsReq2Event(in *pb.RankCardsReq) *commonM.Event {
+
+	channelEvent := &commonM.ChannelEvent{
+		ChannelIDs:    []*commonM.ChannelIDEvent{},
+		ChannelLabels: map[int32]bool{},
+	}
+
+	if in.ChannelID != "" {
+		channelEvent.ChannelIDs = append(channelEvent.ChannelIDs, &commonM.ChannelIDEvent{
+			ChannelID: in.ChannelID,
+		})
+	}
+
+	for _, label := range in.ChannelLabels {
+		channelEvent.ChannelLabels[label] = true
+	}
+
+	payEvent := &commonM.PayEvent{
+		Status: commonM.Whatever,
+		PayIDs: map[string]bool{},
+	}
+
+	if in.PayID != "" {
+		payEvent.Status = commonM.Use
+		payEvent.PayIDs[in.PayID] = true
+	}
+
+	return &commonM.Event{
+		Date:         in.Date,
+		Cost:         in.Cost,
+		ChannelEvent: channelEvent,
+		PayEvent:     payEvent,
+		UserEvent:    TransferUserEventReq2UserEvent(in.UserEvent),
+	}
+}
+
+func TransferCardRankDTOs2CardRanksReply(cardRankDTOs []*evaluationDTO.CardRankDTO) []*pb.CardRanksReply_CardRank {
+
+	cardRanks := []*pb.CardRanksReply_CardRank{}
+
+	for _, c := range cardRankDTOs {
+		cardRanks = append(cardRanks, &pb.CardRanksReply_CardRank{
+			CardID:     c.CardID,
+			CardName:   c.CardName,
+			BankID:     c.BankID,
+			Amount:     c.Amount,
+			Rate:       c.Rate,
+			Evaluation: TransferEvaluationDTO2EvaluationReply(c.Evaluation),
+		})
+	}
+
+	return cardRanks
+}
+
+func TransferEventsReq2Events(in []*pb.Event) []*commonM.Event {
+
+	events := []*commonM.Event{}
+
+	for _, e := range in {
+		if event := TransferEventReq2Event(e); event != nil {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func TransferSimulationDTO2SimulationReply(simulationDTO *evaluationDTO.SimulationDTO) ([]*pb.SimulationReply_EventSimulation, []*pb.SimulationReply_CardSimulation) {
+
+	eventSimulations := []*pb.SimulationReply_EventSimulation{}
+	for _, e := range simulationDTO.EventSimulations {
+		eventSimulations = append(eventSimulations, &pb.SimulationReply_EventSimulation{
+			EventID:    e.EventID,
+			Date:       e.Date,
+			Cost:       e.Cost,
+			CardID:     e.CardID,
+			Amount:     e.Amount,
+			Rate:       e.Rate,
+			Evaluation: TransferEvaluationDTO2EvaluationReply(e.Evaluation),
+		})
+	}
+
+	cardSimulations := []*pb.SimulationReply_CardSimulation{}
+	for _, c := range simulationDTO.CardSimulations {
+		cardSimulations = append(cardSimulations, &pb.SimulationReply_CardSimulation{
+			CardID:     c.CardID,
+			CardName:   c.CardName,
+			EventCount: c.EventCount,
+			Cost:       c.Cost,
+			Cash:       c.Cash,
+			Point:      c.Point,
+			Amount:     c.Amount,
+		})
+	}
+
+	return eventSimulations, cardSimulations
+}
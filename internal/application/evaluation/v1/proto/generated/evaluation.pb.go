@@ -0,0 +1,2084 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: evaluation.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Reply is the status of a call. A failed call returns a gRPC status error
+// with an ErrorInfo detail, clients sending the metadata x-error-mode: reply
+// get the reply with status 1 and error set and no gRPC error instead.
+type Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  *Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Reply) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode    int32  `protobuf:"varint,1,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Error) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *Error) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date         int64               `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	Cost         int32               `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	ChannelEvent *Event_ChannelEvent `protobuf:"bytes,4,opt,name=channelEvent,proto3" json:"channelEvent,omitempty"`
+	PayEvent     *Event_PayEvent     `protobuf:"bytes,5,opt,name=payEvent,proto3" json:"payEvent,omitempty"`
+	CardEvent    *Event_CardEvent    `protobuf:"bytes,6,opt,name=cardEvent,proto3" json:"cardEvent,omitempty"`
+	UserEvent    *Event_UserEvent    `protobuf:"bytes,7,opt,name=userEvent,proto3" json:"userEvent,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *Event) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Event) GetChannelEvent() *Event_ChannelEvent {
+	if x != nil {
+		return x.ChannelEvent
+	}
+	return nil
+}
+
+func (x *Event) GetPayEvent() *Event_PayEvent {
+	if x != nil {
+		return x.PayEvent
+	}
+	return nil
+}
+
+func (x *Event) GetCardEvent() *Event_CardEvent {
+	if x != nil {
+		return x.CardEvent
+	}
+	return nil
+}
+
+func (x *Event) GetUserEvent() *Event_UserEvent {
+	if x != nil {
+		return x.UserEvent
+	}
+	return nil
+}
+
+type EvaluateEventReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID string `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Event  *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *EvaluateEventReq) Reset() {
+	*x = EvaluateEventReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateEventReq) ProtoMessage() {}
+
+func (x *EvaluateEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateEventReq.ProtoReflect.Descriptor instead.
+func (*EvaluateEventReq) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EvaluateEventReq) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *EvaluateEventReq) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type EvaluationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply      *Reply                      `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Evaluation *EvaluationReply_Evaluation `protobuf:"bytes,2,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *EvaluationReply) Reset() {
+	*x = EvaluationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationReply) ProtoMessage() {}
+
+func (x *EvaluationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationReply.ProtoReflect.Descriptor instead.
+func (*EvaluationReply) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EvaluationReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *EvaluationReply) GetEvaluation() *EvaluationReply_Evaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+type RankCardsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelID     string           `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	ChannelLabels []int32          `protobuf:"varint,2,rep,packed,name=channelLabels,proto3" json:"channelLabels,omitempty"`
+	Cost          int32            `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Date          int64            `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
+	PayID         string           `protobuf:"bytes,5,opt,name=payID,proto3" json:"payID,omitempty"`
+	UserEvent     *Event_UserEvent `protobuf:"bytes,6,opt,name=userEvent,proto3" json:"userEvent,omitempty"`
+}
+
+func (x *RankCardsReq) Reset() {
+	*x = RankCardsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankCardsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankCardsReq) ProtoMessage() {}
+
+func (x *RankCardsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankCardsReq.ProtoReflect.Descriptor instead.
+func (*RankCardsReq) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RankCardsReq) GetChannelID() string {
+	if x != nil {
+		return x.ChannelID
+	}
+	return ""
+}
+
+func (x *RankCardsReq) GetChannelLabels() []int32 {
+	if x != nil {
+		return x.ChannelLabels
+	}
+	return nil
+}
+
+func (x *RankCardsReq) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *RankCardsReq) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *RankCardsReq) GetPayID() string {
+	if x != nil {
+		return x.PayID
+	}
+	return ""
+}
+
+func (x *RankCardsReq) GetUserEvent() *Event_UserEvent {
+	if x != nil {
+		return x.UserEvent
+	}
+	return nil
+}
+
+type CardRanksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply     *Reply                     `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	CardRanks []*CardRanksReply_CardRank `protobuf:"bytes,2,rep,name=cardRanks,proto3" json:"cardRanks,omitempty"`
+}
+
+func (x *CardRanksReply) Reset() {
+	*x = CardRanksReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardRanksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardRanksReply) ProtoMessage() {}
+
+func (x *CardRanksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardRanksReply.ProtoReflect.Descriptor instead.
+func (*CardRanksReply) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CardRanksReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *CardRanksReply) GetCardRanks() []*CardRanksReply_CardRank {
+	if x != nil {
+		return x.CardRanks
+	}
+	return nil
+}
+
+type SimulateEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events  []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	CardIDs []string `protobuf:"bytes,2,rep,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+}
+
+func (x *SimulateEventsReq) Reset() {
+	*x = SimulateEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateEventsReq) ProtoMessage() {}
+
+func (x *SimulateEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateEventsReq.ProtoReflect.Descriptor instead.
+func (*SimulateEventsReq) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SimulateEventsReq) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SimulateEventsReq) GetCardIDs() []string {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+type SimulationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply            *Reply                             `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	EventSimulations []*SimulationReply_EventSimulation `protobuf:"bytes,2,rep,name=eventSimulations,proto3" json:"eventSimulations,omitempty"`
+	CardSimulations  []*SimulationReply_CardSimulation  `protobuf:"bytes,3,rep,name=cardSimulations,proto3" json:"cardSimulations,omitempty"`
+}
+
+func (x *SimulationReply) Reset() {
+	*x = SimulationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationReply) ProtoMessage() {}
+
+func (x *SimulationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationReply.ProtoReflect.Descriptor instead.
+func (*SimulationReply) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SimulationReply) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *SimulationReply) GetEventSimulations() []*SimulationReply_EventSimulation {
+	if x != nil {
+		return x.EventSimulations
+	}
+	return nil
+}
+
+func (x *SimulationReply) GetCardSimulations() []*SimulationReply_CardSimulation {
+	if x != nil {
+		return x.CardSimulations
+	}
+	return nil
+}
+
+type Event_ChannelIDEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelID     string         `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	ChannelLabels map[int32]bool `protobuf:"bytes,2,rep,name=channelLabels,proto3" json:"channelLabels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Event_ChannelIDEvent) Reset() {
+	*x = Event_ChannelIDEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ChannelIDEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ChannelIDEvent) ProtoMessage() {}
+
+func (x *Event_ChannelIDEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ChannelIDEvent.ProtoReflect.Descriptor instead.
+func (*Event_ChannelIDEvent) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Event_ChannelIDEvent) GetChannelID() string {
+	if x != nil {
+		return x.ChannelID
+	}
+	return ""
+}
+
+func (x *Event_ChannelIDEvent) GetChannelLabels() map[int32]bool {
+	if x != nil {
+		return x.ChannelLabels
+	}
+	return nil
+}
+
+type Event_ChannelEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelIDs    []*Event_ChannelIDEvent `protobuf:"bytes,1,rep,name=channelIDs,proto3" json:"channelIDs,omitempty"`
+	ChannelLabels map[int32]bool          `protobuf:"bytes,2,rep,name=channelLabels,proto3" json:"channelLabels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Event_ChannelEvent) Reset() {
+	*x = Event_ChannelEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ChannelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ChannelEvent) ProtoMessage() {}
+
+func (x *Event_ChannelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ChannelEvent.ProtoReflect.Descriptor instead.
+func (*Event_ChannelEvent) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Event_ChannelEvent) GetChannelIDs() []*Event_ChannelIDEvent {
+	if x != nil {
+		return x.ChannelIDs
+	}
+	return nil
+}
+
+func (x *Event_ChannelEvent) GetChannelLabels() map[int32]bool {
+	if x != nil {
+		return x.ChannelLabels
+	}
+	return nil
+}
+
+type Event_PayEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	PayIDs map[string]bool `protobuf:"bytes,2,rep,name=payIDs,proto3" json:"payIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Event_PayEvent) Reset() {
+	*x = Event_PayEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_PayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_PayEvent) ProtoMessage() {}
+
+func (x *Event_PayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_PayEvent.ProtoReflect.Descriptor instead.
+func (*Event_PayEvent) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Event_PayEvent) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Event_PayEvent) GetPayIDs() map[string]bool {
+	if x != nil {
+		return x.PayIDs
+	}
+	return nil
+}
+
+type Event_CardEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardType int32          `protobuf:"varint,1,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	TaskLabels map[int32]bool `protobuf:"bytes,2,rep,name=taskLabels,proto3" json:"taskLabels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Event_CardEvent) Reset() {
+	*x = Event_CardEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_CardEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_CardEvent) ProtoMessage() {}
+
+func (x *Event_CardEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_CardEvent.ProtoReflect.Descriptor instead.
+func (*Event_CardEvent) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Event_CardEvent) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *Event_CardEvent) GetTaskLabels() map[int32]bool {
+	if x != nil {
+		return x.TaskLabels
+	}
+	return nil
+}
+
+type Event_UserEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewCustomerCardIDs  map[string]bool `protobuf:"bytes,1,rep,name=newCustomerCardIDs,proto3" json:"newCustomerCardIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RegisteredRewardIDs map[string]bool `protobuf:"bytes,2,rep,name=registeredRewardIDs,proto3" json:"registeredRewardIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Event_UserEvent) Reset() {
+	*x = Event_UserEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UserEvent) ProtoMessage() {}
+
+func (x *Event_UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UserEvent.ProtoReflect.Descriptor instead.
+func (*Event_UserEvent) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Event_UserEvent) GetNewCustomerCardIDs() map[string]bool {
+	if x != nil {
+		return x.NewCustomerCardIDs
+	}
+	return nil
+}
+
+func (x *Event_UserEvent) GetRegisteredRewardIDs() map[string]bool {
+	if x != nil {
+		return x.RegisteredRewardIDs
+	}
+	return nil
+}
+
+type EvaluationReply_ConstraintEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConstraintType   int32  `protobuf:"varint,1,opt,name=constraintType,proto3" json:"constraintType,omitempty"`
+	ConstraintName   string `protobuf:"bytes,2,opt,name=constraintName,proto3" json:"constraintName,omitempty"`
+	ConstraintStatus int32  `protobuf:"varint,3,opt,name=constraintStatus,proto3" json:"constraintStatus,omitempty"`
+}
+
+func (x *EvaluationReply_ConstraintEvaluation) Reset() {
+	*x = EvaluationReply_ConstraintEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationReply_ConstraintEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationReply_ConstraintEvaluation) ProtoMessage() {}
+
+func (x *EvaluationReply_ConstraintEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationReply_ConstraintEvaluation.ProtoReflect.Descriptor instead.
+func (*EvaluationReply_ConstraintEvaluation) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *EvaluationReply_ConstraintEvaluation) GetConstraintType() int32 {
+	if x != nil {
+		return x.ConstraintType
+	}
+	return 0
+}
+
+func (x *EvaluationReply_ConstraintEvaluation) GetConstraintName() string {
+	if x != nil {
+		return x.ConstraintName
+	}
+	return ""
+}
+
+func (x *EvaluationReply_ConstraintEvaluation) GetConstraintStatus() int32 {
+	if x != nil {
+		return x.ConstraintStatus
+	}
+	return 0
+}
+
+type EvaluationReply_RewardEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardID              string                                  `protobuf:"bytes,1,opt,name=rewardID,proto3" json:"rewardID,omitempty"`
+	Name                  string                                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RewardType            int32                                   `protobuf:"varint,3,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Percentage            float64                                 `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Amount                float64                                 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	MatchedChannelIDs     []string                                `protobuf:"bytes,6,rep,name=matchedChannelIDs,proto3" json:"matchedChannelIDs,omitempty"`
+	MatchedChannelLabels  []int32                                 `protobuf:"varint,7,rep,packed,name=matchedChannelLabels,proto3" json:"matchedChannelLabels,omitempty"`
+	MatchedPayIDs         []string                                `protobuf:"bytes,8,rep,name=matchedPayIDs,proto3" json:"matchedPayIDs,omitempty"`
+	CalculateType         int32                                   `protobuf:"varint,9,opt,name=calculateType,proto3" json:"calculateType,omitempty"`
+	Fixed                 float64                                 `protobuf:"fixed64,10,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Capped                bool                                    `protobuf:"varint,11,opt,name=capped,proto3" json:"capped,omitempty"`
+	ConstraintStatus      int32                                   `protobuf:"varint,12,opt,name=constraintStatus,proto3" json:"constraintStatus,omitempty"`
+	ConstraintEvaluations []*EvaluationReply_ConstraintEvaluation `protobuf:"bytes,13,rep,name=constraintEvaluations,proto3" json:"constraintEvaluations,omitempty"`
+}
+
+func (x *EvaluationReply_RewardEvaluation) Reset() {
+	*x = EvaluationReply_RewardEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationReply_RewardEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationReply_RewardEvaluation) ProtoMessage() {}
+
+func (x *EvaluationReply_RewardEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationReply_RewardEvaluation.ProtoReflect.Descriptor instead.
+func (*EvaluationReply_RewardEvaluation) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetRewardID() string {
+	if x != nil {
+		return x.RewardID
+	}
+	return ""
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetRewardType() int32 {
+	if x != nil {
+		return x.RewardType
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetMatchedChannelIDs() []string {
+	if x != nil {
+		return x.MatchedChannelIDs
+	}
+	return nil
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetMatchedChannelLabels() []int32 {
+	if x != nil {
+		return x.MatchedChannelLabels
+	}
+	return nil
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetMatchedPayIDs() []string {
+	if x != nil {
+		return x.MatchedPayIDs
+	}
+	return nil
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetCalculateType() int32 {
+	if x != nil {
+		return x.CalculateType
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetFixed() float64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetCapped() bool {
+	if x != nil {
+		return x.Capped
+	}
+	return false
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetConstraintStatus() int32 {
+	if x != nil {
+		return x.ConstraintStatus
+	}
+	return 0
+}
+
+func (x *EvaluationReply_RewardEvaluation) GetConstraintEvaluations() []*EvaluationReply_ConstraintEvaluation {
+	if x != nil {
+		return x.ConstraintEvaluations
+	}
+	return nil
+}
+
+type EvaluationReply_Evaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID            string                              `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	EventID           string                              `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Date              int64                               `protobuf:"varint,3,opt,name=date,proto3" json:"date,omitempty"`
+	Cost              int32                               `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Cash              float64                             `protobuf:"fixed64,5,opt,name=cash,proto3" json:"cash,omitempty"`
+	Point             float64                             `protobuf:"fixed64,6,opt,name=point,proto3" json:"point,omitempty"`
+	RewardEvaluations []*EvaluationReply_RewardEvaluation `protobuf:"bytes,7,rep,name=rewardEvaluations,proto3" json:"rewardEvaluations,omitempty"`
+}
+
+func (x *EvaluationReply_Evaluation) Reset() {
+	*x = EvaluationReply_Evaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationReply_Evaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationReply_Evaluation) ProtoMessage() {}
+
+func (x *EvaluationReply_Evaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationReply_Evaluation.ProtoReflect.Descriptor instead.
+func (*EvaluationReply_Evaluation) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *EvaluationReply_Evaluation) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *EvaluationReply_Evaluation) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *EvaluationReply_Evaluation) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *EvaluationReply_Evaluation) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *EvaluationReply_Evaluation) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *EvaluationReply_Evaluation) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *EvaluationReply_Evaluation) GetRewardEvaluations() []*EvaluationReply_RewardEvaluation {
+	if x != nil {
+		return x.RewardEvaluations
+	}
+	return nil
+}
+
+type CardRanksReply_CardRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID     string                      `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CardName   string                      `protobuf:"bytes,2,opt,name=cardName,proto3" json:"cardName,omitempty"`
+	BankID     string                      `protobuf:"bytes,3,opt,name=bankID,proto3" json:"bankID,omitempty"`
+	Amount     float64                     `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate       float64                     `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Evaluation *EvaluationReply_Evaluation `protobuf:"bytes,6,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *CardRanksReply_CardRank) Reset() {
+	*x = CardRanksReply_CardRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardRanksReply_CardRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardRanksReply_CardRank) ProtoMessage() {}
+
+func (x *CardRanksReply_CardRank) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardRanksReply_CardRank.ProtoReflect.Descriptor instead.
+func (*CardRanksReply_CardRank) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *CardRanksReply_CardRank) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *CardRanksReply_CardRank) GetCardName() string {
+	if x != nil {
+		return x.CardName
+	}
+	return ""
+}
+
+func (x *CardRanksReply_CardRank) GetBankID() string {
+	if x != nil {
+		return x.BankID
+	}
+	return ""
+}
+
+func (x *CardRanksReply_CardRank) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CardRanksReply_CardRank) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CardRanksReply_CardRank) GetEvaluation() *EvaluationReply_Evaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+type SimulationReply_EventSimulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID    string                      `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Date       int64                       `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	Cost       int32                       `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	CardID     string                      `protobuf:"bytes,4,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Amount     float64                     `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate       float64                     `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Evaluation *EvaluationReply_Evaluation `protobuf:"bytes,7,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *SimulationReply_EventSimulation) Reset() {
+	*x = SimulationReply_EventSimulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationReply_EventSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationReply_EventSimulation) ProtoMessage() {}
+
+func (x *SimulationReply_EventSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationReply_EventSimulation.ProtoReflect.Descriptor instead.
+func (*SimulationReply_EventSimulation) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SimulationReply_EventSimulation) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *SimulationReply_EventSimulation) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *SimulationReply_EventSimulation) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *SimulationReply_EventSimulation) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *SimulationReply_EventSimulation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SimulationReply_EventSimulation) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SimulationReply_EventSimulation) GetEvaluation() *EvaluationReply_Evaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
+type SimulationReply_CardSimulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID     string  `protobuf:"bytes,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CardName   string  `protobuf:"bytes,2,opt,name=cardName,proto3" json:"cardName,omitempty"`
+	EventCount int32   `protobuf:"varint,3,opt,name=eventCount,proto3" json:"eventCount,omitempty"`
+	Cost       int64   `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Cash       float64 `protobuf:"fixed64,5,opt,name=cash,proto3" json:"cash,omitempty"`
+	Point      float64 `protobuf:"fixed64,6,opt,name=point,proto3" json:"point,omitempty"`
+	Amount     float64 `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SimulationReply_CardSimulation) Reset() {
+	*x = SimulationReply_CardSimulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationReply_CardSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationReply_CardSimulation) ProtoMessage() {}
+
+func (x *SimulationReply_CardSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationReply_CardSimulation.ProtoReflect.Descriptor instead.
+func (*SimulationReply_CardSimulation) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *SimulationReply_CardSimulation) GetCardID() string {
+	if x != nil {
+		return x.CardID
+	}
+	return ""
+}
+
+func (x *SimulationReply_CardSimulation) GetCardName() string {
+	if x != nil {
+		return x.CardName
+	}
+	return ""
+}
+
+func (x *SimulationReply_CardSimulation) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *SimulationReply_CardSimulation) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *SimulationReply_CardSimulation) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *SimulationReply_CardSimulation) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *SimulationReply_CardSimulation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_evaluation_proto protoreflect.FileDescriptor
+
+var file_evaluation_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x22, 0x4b, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x0b, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a,
+	0x09, 0x63, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0xce, 0x01, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x5c, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x44, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf1, 0x01, 0x0a, 0x0c, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73,
+	0x12, 0x5a, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x40, 0x0a, 0x12,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa0,
+	0x01, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x70, 0x61, 0x79, 0x49, 0x44, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x49, 0x44, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0xba, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x4e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xed,
+	0x02, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x12,
+	0x6e, 0x65, 0x77, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x6e, 0x65, 0x77, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x73, 0x12, 0x69, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x1a,
+	0x45, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x56,
+	0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x08, 0x0a, 0x0f, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
+	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x92, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x8d, 0x04, 0x0a, 0x10, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x73, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x14, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x61, 0x79, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x69, 0x0a, 0x15, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x15, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xef, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63,
+	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x11, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x6e,
+	0x6b, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xd2, 0x02, 0x0a, 0x0e, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x64,
+	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0xcd,
+	0x01, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b,
+	0x0a, 0x11, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x22, 0x94, 0x05, 0x0a, 0x0f,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5a, 0x0a, 0x10, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x63, 0x61, 0x72, 0x64, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x63, 0x61, 0x72, 0x64, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0xe2, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xba, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x72, 0x64, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x63, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x32, 0x83, 0x02, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x31, 0x12, 0x52, 0x0a, 0x0d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x6b, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x70, 0x69, 0x63, 0x6b,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evaluation_proto_rawDescOnce sync.Once
+	file_evaluation_proto_rawDescData = file_evaluation_proto_rawDesc
+)
+
+func file_evaluation_proto_rawDescGZIP() []byte {
+	file_evaluation_proto_rawDescOnce.Do(func() {
+		file_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(file_evaluation_proto_rawDescData)
+	})
+	return file_evaluation_proto_rawDescData
+}
+
+var file_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_evaluation_proto_goTypes = []interface{}{
+	(*Reply)(nil),                // 0: evaluation.v1.Reply
+	(*Error)(nil),                // 1: evaluation.v1.Error
+	(*Event)(nil),                // 2: evaluation.v1.Event
+	(*EvaluateEventReq)(nil),     // 3: evaluation.v1.EvaluateEventReq
+	(*EvaluationReply)(nil),      // 4: evaluation.v1.EvaluationReply
+	(*RankCardsReq)(nil),         // 5: evaluation.v1.RankCardsReq
+	(*CardRanksReply)(nil),       // 6: evaluation.v1.CardRanksReply
+	(*SimulateEventsReq)(nil),    // 7: evaluation.v1.SimulateEventsReq
+	(*SimulationReply)(nil),      // 8: evaluation.v1.SimulationReply
+	(*Event_ChannelIDEvent)(nil), // 9: evaluation.v1.Event.ChannelIDEvent
+	(*Event_ChannelEvent)(nil),   // 10: evaluation.v1.Event.ChannelEvent
+	(*Event_PayEvent)(nil),       // 11: evaluation.v1.Event.PayEvent
+	(*Event_CardEvent)(nil),      // 12: evaluation.v1.Event.CardEvent
+	(*Event_UserEvent)(nil),      // 13: evaluation.v1.Event.UserEvent
+	nil,                          // 14: evaluation.v1.Event.ChannelIDEvent.ChannelLabelsEntry
+	nil,                          // 15: evaluation.v1.Event.ChannelEvent.ChannelLabelsEntry
+	nil,                          // 16: evaluation.v1.Event.PayEvent.PayIDsEntry
+	nil,                          // 17: evaluation.v1.Event.CardEvent.TaskLabelsEntry
+	nil,                          // 18: evaluation.v1.Event.UserEvent.NewCustomerCardIDsEntry
+	nil,                          // 19: evaluation.v1.Event.UserEvent.RegisteredRewardIDsEntry
+	(*EvaluationReply_ConstraintEvaluation)(nil), // 20: evaluation.v1.EvaluationReply.ConstraintEvaluation
+	(*EvaluationReply_RewardEvaluation)(nil),     // 21: evaluation.v1.EvaluationReply.RewardEvaluation
+	(*EvaluationReply_Evaluation)(nil),           // 22: evaluation.v1.EvaluationReply.Evaluation
+	(*CardRanksReply_CardRank)(nil),              // 23: evaluation.v1.CardRanksReply.CardRank
+	(*SimulationReply_EventSimulation)(nil),      // 24: evaluation.v1.SimulationReply.EventSimulation
+	(*SimulationReply_CardSimulation)(nil),       // 25: evaluation.v1.SimulationReply.CardSimulation
+}
+var file_evaluation_proto_depIdxs = []int32{
+	1,  // 0: evaluation.v1.Reply.error:type_name -> evaluation.v1.Error
+	10, // 1: evaluation.v1.Event.channelEvent:type_name -> evaluation.v1.Event.ChannelEvent
+	11, // 2: evaluation.v1.Event.payEvent:type_name -> evaluation.v1.Event.PayEvent
+	12, // 3: evaluation.v1.Event.cardEvent:type_name -> evaluation.v1.Event.CardEvent
+	13, // 4: evaluation.v1.Event.userEvent:type_name -> evaluation.v1.Event.UserEvent
+	2,  // 5: evaluation.v1.EvaluateEventReq.event:type_name -> evaluation.v1.Event
+	0,  // 6: evaluation.v1.EvaluationReply.reply:type_name -> evaluation.v1.Reply
+	22, // 7: evaluation.v1.EvaluationReply.evaluation:type_name -> evaluation.v1.EvaluationReply.Evaluation
+	13, // 8: evaluation.v1.RankCardsReq.userEvent:type_name -> evaluation.v1.Event.UserEvent
+	0,  // 9: evaluation.v1.CardRanksReply.reply:type_name -> evaluation.v1.Reply
+	23, // 10: evaluation.v1.CardRanksReply.cardRanks:type_name -> evaluation.v1.CardRanksReply.CardRank
+	2,  // 11: evaluation.v1.SimulateEventsReq.events:type_name -> evaluation.v1.Event
+	0,  // 12: evaluation.v1.SimulationReply.reply:type_name -> evaluation.v1.Reply
+	24, // 13: evaluation.v1.SimulationReply.eventSimulations:type_name -> evaluation.v1.SimulationReply.EventSimulation
+	25, // 14: evaluation.v1.SimulationReply.cardSimulations:type_name -> evaluation.v1.SimulationReply.CardSimulation
+	14, // 15: evaluation.v1.Event.ChannelIDEvent.channelLabels:type_name -> evaluation.v1.Event.ChannelIDEvent.ChannelLabelsEntry
+	9,  // 16: evaluation.v1.Event.ChannelEvent.channelIDs:type_name -> evaluation.v1.Event.ChannelIDEvent
+	15, // 17: evaluation.v1.Event.ChannelEvent.channelLabels:type_name -> evaluation.v1.Event.ChannelEvent.ChannelLabelsEntry
+	16, // 18: evaluation.v1.Event.PayEvent.payIDs:type_name -> evaluation.v1.Event.PayEvent.PayIDsEntry
+	17, // 19: evaluation.v1.Event.CardEvent.taskLabels:type_name -> evaluation.v1.Event.CardEvent.TaskLabelsEntry
+	18, // 20: evaluation.v1.Event.UserEvent.newCustomerCardIDs:type_name -> evaluation.v1.Event.UserEvent.NewCustomerCardIDsEntry
+	19, // 21: evaluation.v1.Event.UserEvent.registeredRewardIDs:type_name -> evaluation.v1.Event.UserEvent.RegisteredRewardIDsEntry
+	20, // 22: evaluation.v1.EvaluationReply.RewardEvaluation.constraintEvaluations:type_name -> evaluation.v1.EvaluationReply.ConstraintEvaluation
+	21, // 23: evaluation.v1.EvaluationReply.Evaluation.rewardEvaluations:type_name -> evaluation.v1.EvaluationReply.RewardEvaluation
+	22, // 24: evaluation.v1.CardRanksReply.CardRank.evaluation:type_name -> evaluation.v1.EvaluationReply.Evaluation
+	22, // 25: evaluation.v1.SimulationReply.EventSimulation.evaluation:type_name -> evaluation.v1.EvaluationReply.Evaluation
+	3,  // 26: evaluation.v1.EvaluationV1.EvaluateEvent:input_type -> evaluation.v1.EvaluateEventReq
+	5,  // 27: evaluation.v1.EvaluationV1.RankCards:input_type -> evaluation.v1.RankCardsReq
+	7,  // 28: evaluation.v1.EvaluationV1.SimulateEvents:input_type -> evaluation.v1.SimulateEventsReq
+	4,  // 29: evaluation.v1.EvaluationV1.EvaluateEvent:output_type -> evaluation.v1.EvaluationReply
+	6,  // 30: evaluation.v1.EvaluationV1.RankCards:output_type -> evaluation.v1.CardRanksReply
+	8,  // 31: evaluation.v1.EvaluationV1.SimulateEvents:output_type -> evaluation.v1.SimulationReply
+	29, // [29:32] is the sub-list for method output_type
+	26, // [26:29] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_evaluation_proto_init() }
+func file_evaluation_proto_init() {
+	if File_evaluation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_evaluation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateEventReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankCardsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRanksReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ChannelIDEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_ChannelEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_PayEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_CardEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_UserEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationReply_ConstraintEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationReply_RewardEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationReply_Evaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRanksReply_CardRank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationReply_EventSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationReply_CardSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evaluation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_evaluation_proto_goTypes,
+		DependencyIndexes: file_evaluation_proto_depIdxs,
+		MessageInfos:      file_evaluation_proto_msgTypes,
+	}.Build()
+	File_evaluation_proto = out.File
+	file_evaluation_proto_rawDesc = nil
+	file_evaluation_proto_goTypes = nil
+	file_evaluation_proto_depIdxs = nil
+}
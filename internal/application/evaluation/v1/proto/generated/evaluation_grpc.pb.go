@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: evaluation.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EvaluationV1Client is the client API for EvaluationV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EvaluationV1Client interface {
+	EvaluateEvent(ctx context.Context, in *EvaluateEventReq, opts ...grpc.CallOption) (*EvaluationReply, error)
+	RankCards(ctx context.Context, in *RankCardsReq, opts ...grpc.CallOption) (*CardRanksReply, error)
+	SimulateEvents(ctx context.Context, in *SimulateEventsReq, opts ...grpc.CallOption) (*SimulationReply, error)
+}
+
+type evaluationV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvaluationV1Client(cc grpc.ClientConnInterface) EvaluationV1Client {
+	return &evaluationV1Client{cc}
+}
+
+func (c *evaluationV1Client) EvaluateEvent(ctx context.Context, in *EvaluateEventReq, opts ...grpc.CallOption) (*EvaluationReply, error) {
+	out := new(EvaluationReply)
+	err := c.cc.Invoke(ctx, "/evaluation.v1.EvaluationV1/EvaluateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationV1Client) RankCards(ctx context.Context, in *RankCardsReq, opts ...grpc.CallOption) (*CardRanksReply, error) {
+	out := new(CardRanksReply)
+	err := c.cc.Invoke(ctx, "/evaluation.v1.EvaluationV1/RankCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationV1Client) SimulateEvents(ctx context.Context, in *SimulateEventsReq, opts ...grpc.CallOption) (*SimulationReply, error) {
+	out := new(SimulationReply)
+	err := c.cc.Invoke(ctx, "/evaluation.v1.EvaluationV1/SimulateEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EvaluationV1Server is the server API for EvaluationV1 service.
+// All implementations must embed UnimplementedEvaluationV1Server
+// for forward compatibility
+type EvaluationV1Server interface {
+	EvaluateEvent(context.Context, *EvaluateEventReq) (*EvaluationReply, error)
+	RankCards(context.Context, *RankCardsReq) (*CardRanksReply, error)
+	SimulateEvents(context.Context, *SimulateEventsReq) (*SimulationReply, error)
+	mustEmbedUnimplementedEvaluationV1Server()
+}
+
+// UnimplementedEvaluationV1Server must be embedded to have forward compatible implementations.
+type UnimplementedEvaluationV1Server struct {
+}
+
+func (UnimplementedEvaluationV1Server) EvaluateEvent(context.Context, *EvaluateEventReq) (*EvaluationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateEvent not implemented")
+}
+func (UnimplementedEvaluationV1Server) RankCards(context.Context, *RankCardsReq) (*CardRanksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankCards not implemented")
+}
+func (UnimplementedEvaluationV1Server) SimulateEvents(context.Context, *SimulateEventsReq) (*SimulationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEvents not implemented")
+}
+func (UnimplementedEvaluationV1Server) mustEmbedUnimplementedEvaluationV1Server() {}
+
+// UnsafeEvaluationV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EvaluationV1Server will
+// result in compilation errors.
+type UnsafeEvaluationV1Server interface {
+	mustEmbedUnimplementedEvaluationV1Server()
+}
+
+func RegisterEvaluationV1Server(s grpc.ServiceRegistrar, srv EvaluationV1Server) {
+	s.RegisterService(&EvaluationV1_ServiceDesc, srv)
+}
+
+func _EvaluationV1_EvaluateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateEventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationV1Server).EvaluateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evaluation.v1.EvaluationV1/EvaluateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationV1Server).EvaluateEvent(ctx, req.(*EvaluateEventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvaluationV1_RankCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankCardsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationV1Server).RankCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evaluation.v1.EvaluationV1/RankCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationV1Server).RankCards(ctx, req.(*RankCardsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvaluationV1_SimulateEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationV1Server).SimulateEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evaluation.v1.EvaluationV1/SimulateEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationV1Server).SimulateEvents(ctx, req.(*SimulateEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EvaluationV1_ServiceDesc is the grpc.ServiceDesc for EvaluationV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EvaluationV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "evaluation.v1.EvaluationV1",
+	HandlerType: (*EvaluationV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EvaluateEvent",
+			Handler:    _EvaluationV1_EvaluateEvent_Handler,
+		},
+		{
+			MethodName: "RankCards",
+			Handler:    _EvaluationV1_RankCards_Handler,
+		},
+		{
+			MethodName: "SimulateEvents",
+			Handler:    _EvaluationV1_SimulateEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evaluation.proto",
+}
@@ -0,0 +1,258 @@
+package application
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"pickrewardapi/base/apis"
+	"pickrewardapi/base/ctx"
+
+	bankPb "pickrewardapi/internal/application/bank/v1/proto/generated"
+	cardPb "pickrewardapi/internal/application/card/v1/proto/generated"
+	channelPb "pickrewardapi/internal/application/channel/v1/proto/generated"
+
+	"pickrewardapi/internal/shared/common/errs"
+)
+
+var marshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// GET /v1/banks?pageSize=&pageToken=
+func (g *gateway) getAllBanks(c *gin.Context) {
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	reply, err := g.bankServer.GetAllBanks(requestCtx(c), &bankPb.AllBanksReq{
+		Page: &bankPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/banks/:id/cards?pageSize=&pageToken=
+func (g *gateway) getCardsByBankID(c *gin.Context) {
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	reply, err := g.cardServer.GetCardsByBankID(requestCtx(c), &cardPb.CardsByBankIDReq{
+		Id:   c.Param("id"),
+		Page: &cardPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/cards/latest?pageSize=&pageToken=
+func (g *gateway) getLatestCards(c *gin.Context) {
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	reply, err := g.cardServer.GetLatestCards(requestCtx(c), &cardPb.LatestCardsReq{
+		Page: &cardPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/cards/search?keyword=&pageSize=&pageToken=
+func (g *gateway) searchCard(c *gin.Context) {
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	reply, err := g.cardServer.SearchCard(requestCtx(c), &cardPb.SearchCardReq{
+		Keyword: c.Query("keyword"),
+		Page:    &cardPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/cards/:id
+func (g *gateway) getCardByID(c *gin.Context) {
+	reply, err := g.cardServer.GetCardByID(requestCtx(c), &cardPb.CardIDReq{
+		Id: c.Param("id"),
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/channels?type=&pageSize=&pageToken=&withLabelNames=
+// GET /v1/channels?ids=&withLabelNames=
+func (g *gateway) getChannels(c *gin.Context) {
+	withLabelNames, ok := queryBool(c, "withLabelNames")
+	if !ok {
+		return
+	}
+
+	if ids := queryStrings(c, "ids"); len(ids) > 0 {
+		reply, err := g.channelServer.GetsByChannelIDs(requestCtx(c), &channelPb.ChannelIDsReq{
+			ChannelIDs:     ids,
+			WithLabelNames: withLabelNames,
+		})
+		render(c, reply, err)
+		return
+	}
+
+	if c.Query("type") == "" {
+		badRequest(c, "type or ids is required")
+		return
+	}
+
+	ctype, ok := queryInt32(c, "type")
+	if !ok {
+		return
+	}
+
+	limit, ok := queryInt32(c, "limit")
+	if !ok {
+		return
+	}
+
+	offset, ok := queryInt32(c, "offset")
+	if !ok {
+		return
+	}
+
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	// limit and offset are still taken from the clients paging before
+	// pageToken.
+	reply, err := g.channelServer.GetChannelsByType(requestCtx(c), &channelPb.ChannelTypeReq{
+		Ctype:          ctype,
+		Limit:          limit,
+		Offset:         offset,
+		WithLabelNames: withLabelNames,
+		Page:           &channelPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+// GET /v1/channels/types
+func (g *gateway) getChannelTypes(c *gin.Context) {
+	reply, err := g.channelServer.GetChannelTypes(requestCtx(c), &channelPb.EmptyReq{})
+	render(c, reply, err)
+}
+
+// GET /v1/channels/search?keyword=&withLabelNames=&pageSize=&pageToken=
+func (g *gateway) searchChannel(c *gin.Context) {
+	withLabelNames, ok := queryBool(c, "withLabelNames")
+	if !ok {
+		return
+	}
+
+	pageSize, pageToken, ok := queryPage(c)
+	if !ok {
+		return
+	}
+
+	reply, err := g.channelServer.SearchChannel(requestCtx(c), &channelPb.SearchChannelReq{
+		Keyword:        c.Query("keyword"),
+		WithLabelNames: withLabelNames,
+		Page:           &channelPb.PageReq{PageSize: pageSize, PageToken: pageToken},
+	})
+	render(c, reply, err)
+}
+
+func requestCtx(c *gin.Context) ctx.CTX {
+	return c.MustGet("ctx").(ctx.CTX)
+}
+
+// render writes reply as JSON with the HTTP status of err, replies of
+// failures carry their Reply.Error as in gRPC.
+func render(c *gin.Context, reply proto.Message, err error) {
+	status := errs.HTTPStatus(err)
+
+	if reply == nil || reflect.ValueOf(reply).IsNil() {
+		c.JSON(status, apis.Reply{
+			Status: 1,
+			Error:  http.StatusText(status),
+		})
+		return
+	}
+
+	body, marshalErr := marshaler.Marshal(reply)
+	if marshalErr != nil {
+		requestCtx(c).Error("protojson.Marshal failed: ", marshalErr)
+		c.JSON(http.StatusInternalServerError, apis.Reply{
+			Status: 1,
+			Error:  http.StatusText(http.StatusInternalServerError),
+		})
+		return
+	}
+
+	c.Data(status, "application/json; charset=utf-8", body)
+}
+
+func badRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, apis.Reply{
+		Status: 1,
+		Error:  message,
+	})
+}
+
+// queryInt32 returns the int32 of the query key, 0 if it is absent, and
+// replies a bad request if it is not a number.
+func queryInt32(c *gin.Context, key string) (int32, bool) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, true
+	}
+
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		badRequest(c, "invalid "+key)
+		return 0, false
+	}
+	return int32(n), true
+}
+
+// queryPage returns the pageSize and pageToken of the query, the size is
+// checked by the server.
+func queryPage(c *gin.Context) (int32, string, bool) {
+	pageSize, ok := queryInt32(c, "pageSize")
+	if !ok {
+		return 0, "", false
+	}
+	return pageSize, c.Query("pageToken"), true
+}
+
+// queryBool returns the bool of the query key, false if it is absent, and
+// replies a bad request if it is not a bool.
+func queryBool(c *gin.Context, key string) (bool, bool) {
+	value := c.Query(key)
+	if value == "" {
+		return false, true
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		badRequest(c, "invalid "+key)
+		return false, false
+	}
+	return b, true
+}
+
+// queryStrings returns the values of the query key given repeated or comma
+// separated.
+func queryStrings(c *gin.Context, key string) []string {
+	values := []string{}
+	for _, v := range c.QueryArray(key) {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
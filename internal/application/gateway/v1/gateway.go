@@ -0,0 +1,204 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"pickrewardapi/base/apis"
+	"pickrewardapi/base/ctx"
+
+	bankApplication "pickrewardapi/internal/application/bank/v1"
+	cardApplication "pickrewardapi/internal/application/card/v1"
+	channelApplication "pickrewardapi/internal/application/channel/v1"
+
+	bankPb "pickrewardapi/internal/application/bank/v1/proto/generated"
+	cardPb "pickrewardapi/internal/application/card/v1/proto/generated"
+	channelPb "pickrewardapi/internal/application/channel/v1/proto/generated"
+
+	bankService "pickrewardapi/internal/domain/bank/service"
+	cardService "pickrewardapi/internal/domain/card/service"
+	channelService "pickrewardapi/internal/domain/channel/service"
+
+	config "pickrewardapi/internal/pkg/config"
+	"pickrewardapi/internal/pkg/tracing"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
+)
+
+// REQUEST_ID_HEADER is the header of the request ID, a request ID sent by the
+// client is kept and the ID is returned in the response header.
+const REQUEST_ID_HEADER = "X-Request-Id"
+
+// TESTER_TOKEN_HEADER is the header of the tester token, the requests sending
+// a valid one read the pilot items as the gRPC calls sending
+// interceptor.TESTER_TOKEN_KEY do.
+const TESTER_TOKEN_HEADER = "X-Tester-Token"
+
+// BODY_SIZE_LIMIT is the max size in bytes of a request body.
+const BODY_SIZE_LIMIT = 1 << 20
+
+// Server serves the Bank, Card and Channel operations as REST endpoints, the
+// endpoints call the gRPC implementations in process and reply their JSON.
+type Server struct {
+	server *http.Server
+}
+
+type gateway struct {
+	bankServer    bankPb.BankV1Server
+	cardServer    cardPb.CardV1Server
+	channelServer channelPb.ChannelV1Server
+}
+
+func NewGatewayServer(
+	cfg *config.Config,
+
+	bankService bankService.BankService,
+	cardService cardService.CardService,
+	channelService channelService.ChannelService,
+) *Server {
+	log.WithFields(log.Fields{
+		"pos": "[gateway.api][NewGatewayServer]",
+	}).Info("Init")
+
+	if cfg.IsProd() {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	g := &gateway{
+		bankServer:    bankApplication.NewBankV1Server(bankService),
+		cardServer:    cardApplication.NewCardV1Server(cardService),
+		channelServer: channelApplication.NewChannelV1Server(channelService),
+	}
+
+	engine := gin.New()
+	engine.Use(
+		withCtx(),
+		withPreview(cfg.App.TesterTokens),
+		apis.SetTimeout(cfg.App.HTTPTimeout),
+		apis.BodySizeLimit(BODY_SIZE_LIMIT),
+	)
+
+	v1 := engine.Group("/v1")
+
+	apis.HandleWithQuery(v1, http.MethodGet, "/banks", "pageSize,pageToken", g.getAllBanks)
+	apis.HandleWithQuery(v1, http.MethodGet, "/banks/:id/cards", "pageSize,pageToken", g.getCardsByBankID)
+
+	apis.HandleWithQuery(v1, http.MethodGet, "/cards/latest", "pageSize,pageToken", g.getLatestCards)
+	apis.HandleWithQuery(v1, http.MethodGet, "/cards/search", "keyword,pageSize,pageToken", g.searchCard)
+	apis.Handle(v1, http.MethodGet, "/cards/:id", g.getCardByID)
+
+	apis.HandleWithQuery(v1, http.MethodGet, "/channels", "type,limit,offset,ids,withLabelNames,pageSize,pageToken", g.getChannels)
+	apis.Handle(v1, http.MethodGet, "/channels/types", g.getChannelTypes)
+	apis.HandleWithQuery(v1, http.MethodGet, "/channels/search", "keyword,withLabelNames,pageSize,pageToken", g.searchChannel)
+
+	return &Server{
+		server: &http.Server{
+			Handler:           engine,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// ListenAndServe serves the gateway on addr until Shutdown is called.
+func (s *Server) ListenAndServe(addr string) error {
+	s.server.Addr = addr
+
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(c context.Context) error {
+	return s.server.Shutdown(c)
+}
+
+// withCtx sets the "ctx" of the request used by base/apis and base/stats, a
+// CTX logging with the request ID and carrying the server span, and logs the
+// request once it is handled.
+func withCtx() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(REQUEST_ID_HEADER)
+		if requestID == "" {
+			requestID = commonS.GenUUID()
+		}
+		c.Header(REQUEST_ID_HEADER, requestID)
+
+		route := c.Request.Method + " " + c.FullPath()
+
+		parent := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		spanCtx, span := tracing.Start(parent, route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPMethod(c.Request.Method), semconv.HTTPRoute(c.FullPath())),
+		)
+		defer span.End()
+
+		logger := log.WithFields(log.Fields{
+			"requestID": requestID,
+			"route":     route,
+		})
+		cc := ctx.New(spanCtx, logger)
+		c.Set("ctx", cc)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPStatusCode(status))
+
+		fields := log.Fields{
+			"pos":     "[gateway.api][withCtx]",
+			"latency": time.Since(start).String(),
+			"status":  status,
+		}
+
+		if n, ok := cc.LoadRedisCacheCount(); ok && n > 0 {
+			fields["redisCacheCount"] = n
+		}
+
+		logger = logger.WithFields(fields)
+
+		if status >= http.StatusInternalServerError {
+			logger.Error("Call failed")
+		} else if status >= http.StatusBadRequest {
+			logger.Warn("Call failed")
+		} else {
+			logger.Info("Call")
+		}
+	}
+}
+
+// withPreview marks the requests sending one of tokens as TESTER_TOKEN_HEADER
+// as previews, a request sending another token is forbidden.
+func withPreview(tokens []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(TESTER_TOKEN_HEADER)
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		if !preview.IsTester(token, tokens) {
+			c.AbortWithStatusJSON(http.StatusForbidden, apis.Reply{
+				Status: 1,
+				Error:  "invalid tester token",
+			})
+			return
+		}
+
+		cc := requestCtx(c)
+		cc.Context = preview.With(cc.Context)
+		c.Set("ctx", cc)
+
+		c.Next()
+	}
+}
@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"errors"
+	"fmt"
+
 	commonM "pickrewardapi/internal/shared/common/model"
 )
 
@@ -12,3 +15,21 @@ type BankDTO struct {
 	CreateDate int64          `json:"createDate"`
 	UpdateDate int64          `json:"updateDate"`
 }
+
+// Validate checks the bank can be stored.
+func (b *BankDTO) Validate() error {
+
+	if b.ID == "" {
+		return errors.New("bank id is empty")
+	}
+
+	if b.Name == "" {
+		return errors.New("bank name is empty")
+	}
+
+	if _, err := commonM.GetStatus(int32(b.BankStatus)); err != nil {
+		return fmt.Errorf("invalid bank status: %d", b.BankStatus)
+	}
+
+	return nil
+}
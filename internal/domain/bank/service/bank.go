@@ -2,21 +2,38 @@ package service
 
 import (
 	"context"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 
+	baseCtx "pickrewardapi/base/ctx"
+
+	"pickrewardapi/internal/shared/common/errs"
 	commonM "pickrewardapi/internal/shared/common/model"
+	"pickrewardapi/internal/shared/common/page"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
 
 	bankDTO "pickrewardapi/internal/domain/bank/dto"
 	bankStore "pickrewardapi/internal/domain/bank/store"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type BankService interface {
 	GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, error)
-	GetAllBanks(ctx context.Context) ([]*bankDTO.BankDTO, error)
+	GetAllBanks(ctx context.Context, req page.Request) ([]*bankDTO.BankDTO, *page.Info, error)
+
+	CreateBank(ctx context.Context, bankDTO *bankDTO.BankDTO) (*bankDTO.BankDTO, error)
+	UpdateBank(ctx context.Context, bankDTO *bankDTO.BankDTO) (*bankDTO.BankDTO, error)
+	UpdateBankStatus(ctx context.Context, ID string, status commonM.Status) (*bankDTO.BankDTO, error)
 }
 
+var (
+	timeNow = time.Now
+)
+
 type impl struct {
 	dig.In
 
@@ -32,19 +49,139 @@ func New(
 }
 
 func (im *impl) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, error) {
-	return im.bankStore.GetBankByID(ctx, ID)
+	ctx, span := tracing.Start(ctx, "bank.service.GetBankByID")
+	defer span.End()
+
+	bank, err := im.bankStore.GetBankByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !preview.Visible(ctx, bank.BankStatus) {
+		return nil, bankStore.ErrBankNotFound
+	}
+
+	return bank, nil
 }
 
-func (im *impl) GetAllBanks(ctx context.Context) ([]*bankDTO.BankDTO, error) {
+func (im *impl) GetAllBanks(ctx context.Context, req page.Request) ([]*bankDTO.BankDTO, *page.Info, error) {
 	logPos := "[card.app.service][GetAllBanks]"
 
-	dtos, err := im.bankStore.GetAllBanks(ctx, commonM.Active)
+	ctx, span := tracing.Start(ctx, "bank.service.GetAllBanks")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	dtos, err := im.bankStore.GetAllBanks(ctx, statuses)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardStore.GetAllBanks failed")
+		return nil, nil, err
+	}
+
+	return page.Slice(dtos, req, "banks", statuses)
+}
+
+func (im *impl) CreateBank(ctx context.Context, bank *bankDTO.BankDTO) (*bankDTO.BankDTO, error) {
+	logPos := "[bank.service][CreateBank]"
+
+	ctx, span := tracing.Start(ctx, "bank.service.CreateBank")
+	defer span.End()
+
+	now := timeNow().Unix()
+	bank.ID = commonS.GenUUID()
+	bank.CreateDate = now
+	bank.UpdateDate = now
+
+	if err := bank.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("bank.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.bankStore.ModifiedBank(ctx, bank); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": bank.ID,
+		}).Error("bankStore.ModifiedBank failed: ", err)
+		return nil, err
+	}
+
+	return bank, nil
+}
+
+func (im *impl) UpdateBank(ctx context.Context, bank *bankDTO.BankDTO) (*bankDTO.BankDTO, error) {
+	logPos := "[bank.service][UpdateBank]"
+
+	ctx, span := tracing.Start(ctx, "bank.service.UpdateBank")
+	defer span.End()
+
+	existing, err := im.bankStore.GetBankByID(ctx, bank.ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": bank.ID,
+		}).Error("bankStore.GetBankByID failed: ", err)
+		return nil, err
+	}
+
+	bank.CreateDate = existing.CreateDate
+	bank.UpdateDate = timeNow().Unix()
+
+	if err := bank.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": bank.ID,
+		}).Error("bank.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.bankStore.ModifiedBank(ctx, bank); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": bank.ID,
+		}).Error("bankStore.ModifiedBank failed: ", err)
+		return nil, err
+	}
+
+	return bank, nil
+}
+
+func (im *impl) UpdateBankStatus(ctx context.Context, ID string, status commonM.Status) (*bankDTO.BankDTO, error) {
+	logPos := "[bank.service][UpdateBankStatus]"
+
+	ctx, span := tracing.Start(ctx, "bank.service.UpdateBankStatus")
+	defer span.End()
+
+	bank, err := im.bankStore.GetBankByID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": ID,
+		}).Error("bankStore.GetBankByID failed: ", err)
+		return nil, err
+	}
+
+	bank.BankStatus = status
+	bank.UpdateDate = timeNow().Unix()
+
+	if err := bank.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": ID,
+		}).Error("bank.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.bankStore.ModifiedBank(ctx, bank); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"bank.ID": ID,
+		}).Error("bankStore.ModifiedBank failed: ", err)
 		return nil, err
 	}
 
-	return dtos, nil
+	return bank, nil
 }
@@ -6,9 +6,12 @@ import (
 	"fmt"
 
 	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	"pickrewardapi/internal/shared/common/errs"
 	commonM "pickrewardapi/internal/shared/common/model"
 
+	"pickrewardapi/internal/pkg/cache"
 	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
 
 	"github.com/jackc/pgx"
 	log "github.com/sirupsen/logrus"
@@ -18,7 +21,7 @@ import (
 type BankStore interface {
 	ModifiedBank(ctx context.Context, bankDTO *bankDTO.BankDTO) error
 	GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, error)
-	GetAllBanks(ctx context.Context, status commonM.Status) ([]*bankDTO.BankDTO, error)
+	GetAllBanks(ctx context.Context, statuses []commonM.Status) ([]*bankDTO.BankDTO, error)
 	GetBankNameByBankID(ctx context.Context, ID string) (*bankDTO.BankDTO, error)
 }
 
@@ -28,18 +31,24 @@ type impl struct {
 	primary *pgx.ConnPool
 }
 
-func New(sql *psql.Psql) BankStore {
+func New(sql *psql.Psql, c *cache.Cache) BankStore {
 	logPos := "[bank.store][New]"
 
 	log.WithFields(log.Fields{
 		"pos": logPos,
 	}).Infof("init bank store")
 
-	return &impl{
-		primary: sql.Primary,
+	return &cached{
+		BankStore: &impl{
+			primary: sql.Primary,
+		},
+		cache: c,
 	}
 }
 
+// ErrBankNotFound is returned when no bank has the ID.
+var ErrBankNotFound = errs.NewNotFound("not found bank")
+
 const BANK = "bank"
 const ALL_COLUMNS = " \"id\", \"name\", \"order\", \"bank_status\", \"create_date\", \"update_date\" "
 
@@ -55,7 +64,10 @@ var MODIFIED_BANK_STAT = fmt.Sprintf(
 func (im *impl) ModifiedBank(ctx context.Context, bankDTO *bankDTO.BankDTO) error {
 	logPos := "[card.store][ModifiedBank]"
 
-	tx, err := im.primary.Begin()
+	ctx, span := tracing.StartQuery(ctx, "bank.store.ModifiedBank", "MODIFIED_BANK_STAT")
+	defer span.End()
+
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -89,15 +101,15 @@ func (im *impl) ModifiedBank(ctx context.Context, bankDTO *bankDTO.BankDTO) erro
 		bankDTO.UpdateDate,
 	}
 
-	if _, err := tx.Exec(MODIFIED_BANK_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, MODIFIED_BANK_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
 			"bank.ID": bankDTO.ID,
-		}).Errorf("tx.Exec failed: ", err)
+		}).Error("tx.Exec failed: ", err)
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -116,7 +128,10 @@ var SELECT_BANK_BY_ID_STAT = fmt.Sprintf(
 func (im *impl) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, error) {
 	logPos := "[bank.store][GetBankByID]"
 
-	rows, err := im.primary.Query(SELECT_BANK_BY_ID_STAT, ID)
+	ctx, span := tracing.StartQuery(ctx, "bank.store.GetBankByID", "SELECT_BANK_BY_ID_STAT")
+	defer span.End()
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_BANK_BY_ID_STAT, nil, ID)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -130,7 +145,7 @@ func (im *impl) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, e
 	var bankResult *bankDTO.BankDTO
 	for rows.Next() {
 
-		bankResult := &bankDTO.BankDTO{}
+		bankResult = &bankDTO.BankDTO{}
 		selector := []interface{}{
 			&bankResult.ID,
 			&bankResult.Name,
@@ -159,7 +174,7 @@ func (im *impl) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, e
 	}
 
 	if bankResult == nil {
-		return nil, errors.New("not found bank")
+		return nil, ErrBankNotFound
 	}
 
 	return bankResult, nil
@@ -168,16 +183,19 @@ func (im *impl) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, e
 
 var SELECT_ALL_BANK_STAT = fmt.Sprintf(
 	"SELECT %s FROM %s "+
-		" WHERE bank_status = $1 "+
-		" ORDER BY \"order\" ",
+		" WHERE bank_status = ANY($1) "+
+		" ORDER BY \"order\", \"id\" ",
 	ALL_COLUMNS, BANK,
 )
 
-func (im *impl) GetAllBanks(ctx context.Context, status commonM.Status) ([]*bankDTO.BankDTO, error) {
+func (im *impl) GetAllBanks(ctx context.Context, statuses []commonM.Status) ([]*bankDTO.BankDTO, error) {
 	logPos := "[bank.store][GetAllBanks]"
 
+	ctx, span := tracing.StartQuery(ctx, "bank.store.GetAllBanks", "SELECT_ALL_BANK_STAT")
+	defer span.End()
+
 	bankDTOs := []*bankDTO.BankDTO{}
-	rows, err := im.primary.Query(SELECT_ALL_BANK_STAT, status)
+	rows, err := im.primary.QueryEx(ctx, SELECT_ALL_BANK_STAT, nil, commonM.Int32s(statuses))
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -194,9 +212,9 @@ func (im *impl) GetAllBanks(ctx context.Context, status commonM.Status) ([]*bank
 			&bankDTO.ID,
 			&bankDTO.Name,
 			&bankDTO.Order,
+			&bankDTO.BankStatus,
 			&bankDTO.CreateDate,
 			&bankDTO.UpdateDate,
-			&bankDTO.BankStatus,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
@@ -218,7 +236,10 @@ const SELECT_BANK_NAME_BY_ID_STAT = "SELECT \"id\", \"name\" " +
 func (im *impl) GetBankNameByBankID(ctx context.Context, ID string) (*bankDTO.BankDTO, error) {
 	logPos := "[bank.store][GetBankNameByBankID]"
 
-	rows, err := im.primary.Query(SELECT_BANK_NAME_BY_ID_STAT, ID)
+	ctx, span := tracing.StartQuery(ctx, "bank.store.GetBankNameByBankID", "SELECT_BANK_NAME_BY_ID_STAT")
+	defer span.End()
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_BANK_NAME_BY_ID_STAT, nil, ID)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -231,7 +252,7 @@ func (im *impl) GetBankNameByBankID(ctx context.Context, ID string) (*bankDTO.Ba
 	defer rows.Close()
 	for rows.Next() {
 
-		bankResult := &bankDTO.BankDTO{}
+		bankResult = &bankDTO.BankDTO{}
 		selector := []interface{}{
 			&bankResult.ID,
 			&bankResult.Name,
@@ -256,7 +277,7 @@ func (im *impl) GetBankNameByBankID(ctx context.Context, ID string) (*bankDTO.Ba
 	}
 
 	if bankResult == nil {
-		return nil, errors.New("not found bank")
+		return nil, ErrBankNotFound
 	}
 	return bankResult, nil
 }
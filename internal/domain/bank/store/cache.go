@@ -0,0 +1,36 @@
+package store
+
+import (
+	"context"
+
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	"pickrewardapi/internal/pkg/cache"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// CACHE_NAMESPACE is the cache namespace of the bank queries.
+const CACHE_NAMESPACE = BANK
+
+// cached reads GetAllBanks through the cache and invalidates the namespace
+// when a bank is modified.
+type cached struct {
+	BankStore
+
+	cache *cache.Cache
+}
+
+func (cs *cached) ModifiedBank(ctx context.Context, bankDTO *bankDTO.BankDTO) error {
+	if err := cs.BankStore.ModifiedBank(ctx, bankDTO); err != nil {
+		return err
+	}
+
+	cs.cache.Invalidate(ctx, CACHE_NAMESPACE)
+	return nil
+}
+
+func (cs *cached) GetAllBanks(ctx context.Context, statuses []commonM.Status) ([]*bankDTO.BankDTO, error) {
+	return cache.Fetch(ctx, cs.cache, cache.Key(CACHE_NAMESPACE, "all", statuses),
+		func(ctx context.Context) ([]*bankDTO.BankDTO, error) {
+			return cs.BankStore.GetAllBanks(ctx, statuses)
+		})
+}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	"pickrewardapi/internal/pkg/memdb"
+	commonM "pickrewardapi/internal/shared/common/model"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// memory is a BankStore kept in memory, used by the demo mode instead of
+// Postgres.
+type memory struct {
+	banks *memdb.Table[string, bankDTO.BankDTO]
+}
+
+func NewMemory() BankStore {
+	logPos := "[bank.store][NewMemory]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Infof("init memory bank store")
+
+	return &memory{
+		banks: memdb.NewTable[string, bankDTO.BankDTO](),
+	}
+}
+
+func (m *memory) ModifiedBank(ctx context.Context, bankDTO *bankDTO.BankDTO) error {
+	return m.banks.Put(bankDTO.ID, bankDTO)
+}
+
+func (m *memory) GetBankByID(ctx context.Context, ID string) (*bankDTO.BankDTO, error) {
+	bank, err := m.banks.Get(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if bank == nil {
+		return nil, ErrBankNotFound
+	}
+
+	return bank, nil
+}
+
+func (m *memory) GetAllBanks(ctx context.Context, statuses []commonM.Status) ([]*bankDTO.BankDTO, error) {
+	banks, err := m.banks.Select(func(b *bankDTO.BankDTO) bool {
+		return slices.Contains(statuses, b.BankStatus)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(banks, func(i, j int) bool {
+		if banks[i].Order != banks[j].Order {
+			return banks[i].Order < banks[j].Order
+		}
+		return banks[i].ID < banks[j].ID
+	})
+
+	return banks, nil
+}
+
+func (m *memory) GetBankNameByBankID(ctx context.Context, ID string) (*bankDTO.BankDTO, error) {
+	bank, err := m.GetBankByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bankDTO.BankDTO{
+		ID:   bank.ID,
+		Name: bank.Name,
+	}, nil
+}
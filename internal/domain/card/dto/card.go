@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"errors"
+	"fmt"
+
 	commonM "pickrewardapi/internal/shared/common/model"
 )
 
@@ -15,3 +18,25 @@ type CardDTO struct {
 	CreateDate   int64          `json:"createDate"`
 	UpdateDate   int64          `json:"updateDate"`
 }
+
+// Validate checks the card can be stored.
+func (c *CardDTO) Validate() error {
+
+	if c.ID == "" {
+		return errors.New("card id is empty")
+	}
+
+	if c.Name == "" {
+		return errors.New("card name is empty")
+	}
+
+	if c.BankID == "" {
+		return errors.New("card bank id is empty")
+	}
+
+	if _, err := commonM.GetStatus(int32(c.CardStatus)); err != nil {
+		return fmt.Errorf("invalid card status: %d", c.CardStatus)
+	}
+
+	return nil
+}
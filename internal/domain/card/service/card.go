@@ -2,24 +2,36 @@ package service
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 
+	baseCtx "pickrewardapi/base/ctx"
+
+	bankStore "pickrewardapi/internal/domain/bank/store"
 	cardStore "pickrewardapi/internal/domain/card/store"
 
 	cardDTO "pickrewardapi/internal/domain/card/dto"
+	"pickrewardapi/internal/shared/common/errs"
 	commonM "pickrewardapi/internal/shared/common/model"
+	"pickrewardapi/internal/shared/common/page"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type CardService interface {
-	GetCardsByBankID(ctx context.Context, bankID string) ([]*cardDTO.CardDTO, error)
-	GetLatestCards(ctx context.Context) ([]*cardDTO.CardDTO, error)
+	GetCardsByBankID(ctx context.Context, bankID string, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error)
+	GetLatestCards(ctx context.Context, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error)
 	GetCardByID(ctx context.Context, cardID string) (*cardDTO.CardDTO, error)
 
-	SearchCard(ctx context.Context, keyword string) ([]*cardDTO.CardDTO, error)
+	SearchCard(ctx context.Context, keyword string, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error)
+
+	CreateCard(ctx context.Context, cardDTO *cardDTO.CardDTO) (*cardDTO.CardDTO, error)
+	UpdateCard(ctx context.Context, cardDTO *cardDTO.CardDTO) (*cardDTO.CardDTO, error)
+	UpdateCardStatus(ctx context.Context, ID string, status commonM.Status) (*cardDTO.CardDTO, error)
 }
 
 var (
@@ -30,78 +42,242 @@ type impl struct {
 	dig.In
 
 	cardStore cardStore.CardStore
+	bankStore bankStore.BankStore
 }
 
 func New(
 	cardStore cardStore.CardStore,
+	bankStore bankStore.BankStore,
 ) CardService {
 
 	impl := &impl{
 		cardStore: cardStore,
+		bankStore: bankStore,
 	}
 
 	return impl
 }
 
-func (im *impl) GetCardsByBankID(ctx context.Context, bankID string) ([]*cardDTO.CardDTO, error) {
+func (im *impl) GetCardsByBankID(ctx context.Context, bankID string, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error) {
 	logPos := "[card.service][GetCardsByBankID]"
 
-	dtos, err := im.cardStore.GetCardsByBankID(ctx, bankID, commonM.Active)
+	ctx, span := tracing.Start(ctx, "card.service.GetCardsByBankID")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	cursor, err := page.Open(req, "cards.bank", bankID, statuses)
 	if err != nil {
-		log.WithFields(log.Fields{
+		return nil, nil, err
+	}
+
+	dtos, total, err := im.cardStore.GetCardsByBankID(ctx, bankID, statuses, cursor.Offset, cursor.Size)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
-		}).Error("cardStore.GetAllBanks failed")
-		return nil, err
+		}).Error("cardStore.GetCardsByBankID failed: ", err)
+		return nil, nil, err
 	}
 
-	return dtos, nil
+	return dtos, cursor.Info(total), nil
 }
 
 func (im *impl) GetCardByID(ctx context.Context, cardID string) (*cardDTO.CardDTO, error) {
 	logPos := "[card.service][GetCardByID]"
 
+	ctx, span := tracing.Start(ctx, "card.service.GetCardByID")
+	defer span.End()
+
 	card, err := im.cardStore.GetByCardID(ctx, cardID)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
-		}).Error("cardStore.GetByCardID failed")
+		}).Error("cardStore.GetByCardID failed: ", err)
+		return nil, err
 	}
 
-	if card == nil {
-		log.WithFields(log.Fields{
+	if card == nil || !preview.Visible(ctx, card.CardStatus) {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos":    logPos,
 			"cardID": cardID,
 		}).Error("Cannot find cardID")
-		return nil, errors.New("Cannot find cardID")
+		return nil, errs.NewNotFound("Cannot find cardID")
 	}
 
 	return card, nil
 }
 
-func (im *impl) GetLatestCards(ctx context.Context) ([]*cardDTO.CardDTO, error) {
+func (im *impl) GetLatestCards(ctx context.Context, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error) {
 	logPos := "[card.service][GetLatestCards]"
 
-	cards, err := im.cardStore.GetLatestCards(ctx)
+	ctx, span := tracing.Start(ctx, "card.service.GetLatestCards")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	cards, err := im.cardStore.GetLatestCards(ctx, statuses)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("cardStore.GetLatestCards failed")
-		return nil, err
+		return nil, nil, err
 	}
 
-	return cards, nil
+	return page.Slice(cards, req, "cards.latest", statuses)
 }
 
-func (im *impl) SearchCard(ctx context.Context, keyword string) ([]*cardDTO.CardDTO, error) {
+func (im *impl) SearchCard(ctx context.Context, keyword string, req page.Request) ([]*cardDTO.CardDTO, *page.Info, error) {
 	logPos := "[card.service][SearchCard]"
 
-	cards, err := im.cardStore.SearchCard(ctx, keyword, commonM.Active)
+	ctx, span := tracing.Start(ctx, "card.service.SearchCard")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	cursor, err := page.Open(req, "cards.search", keyword, statuses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cards, total, err := im.cardStore.SearchCard(ctx, keyword, statuses, cursor.Offset, cursor.Size)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
-		}).Error("cardStore.SearchCard failed")
+		}).Error("cardStore.SearchCard failed: ", err)
+		return nil, nil, err
+	}
+
+	return cards, cursor.Info(total), nil
+}
+
+func (im *impl) CreateCard(ctx context.Context, card *cardDTO.CardDTO) (*cardDTO.CardDTO, error) {
+	logPos := "[card.service][CreateCard]"
+
+	ctx, span := tracing.Start(ctx, "card.service.CreateCard")
+	defer span.End()
+
+	now := timeNow().Unix()
+	card.ID = commonS.GenUUID()
+	card.CreateDate = now
+	card.UpdateDate = now
+
+	if err := im.validateCard(ctx, card); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validateCard failed: ", err)
 		return nil, err
 	}
 
-	return cards, nil
+	if err := im.cardStore.ModifiedCard(ctx, card); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": card.ID,
+		}).Error("cardStore.ModifiedCard failed: ", err)
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (im *impl) UpdateCard(ctx context.Context, card *cardDTO.CardDTO) (*cardDTO.CardDTO, error) {
+	logPos := "[card.service][UpdateCard]"
+
+	ctx, span := tracing.Start(ctx, "card.service.UpdateCard")
+	defer span.End()
+
+	existing, err := im.cardStore.GetByCardID(ctx, card.ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": card.ID,
+		}).Error("cardStore.GetByCardID failed: ", err)
+		return nil, err
+	}
+
+	if existing == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": card.ID,
+		}).Error("Cannot find cardID")
+		return nil, errs.NewNotFound("Cannot find cardID")
+	}
+
+	card.CreateDate = existing.CreateDate
+	card.UpdateDate = timeNow().Unix()
+
+	if err := im.validateCard(ctx, card); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": card.ID,
+		}).Error("validateCard failed: ", err)
+		return nil, err
+	}
+
+	if err := im.cardStore.ModifiedCard(ctx, card); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": card.ID,
+		}).Error("cardStore.ModifiedCard failed: ", err)
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (im *impl) UpdateCardStatus(ctx context.Context, ID string, status commonM.Status) (*cardDTO.CardDTO, error) {
+	logPos := "[card.service][UpdateCardStatus]"
+
+	ctx, span := tracing.Start(ctx, "card.service.UpdateCardStatus")
+	defer span.End()
+
+	card, err := im.cardStore.GetByCardID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": ID,
+		}).Error("cardStore.GetByCardID failed: ", err)
+		return nil, err
+	}
+
+	if card == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": ID,
+		}).Error("Cannot find cardID")
+		return nil, errs.NewNotFound("Cannot find cardID")
+	}
+
+	card.CardStatus = status
+	card.UpdateDate = timeNow().Unix()
+
+	if err := card.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": ID,
+		}).Error("card.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.cardStore.ModifiedCard(ctx, card); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":     logPos,
+			"card.ID": ID,
+		}).Error("cardStore.ModifiedCard failed: ", err)
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (im *impl) validateCard(ctx context.Context, card *cardDTO.CardDTO) error {
+
+	if err := card.Validate(); err != nil {
+		return errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if _, err := im.bankStore.GetBankByID(ctx, card.BankID); err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	"pickrewardapi/internal/pkg/cache"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// CACHE_NAMESPACE is the cache namespace of the card queries.
+const CACHE_NAMESPACE = CARD
+
+// cached reads GetByCardID and GetLatestCards through the cache and
+// invalidates the namespace when a card is modified.
+type cached struct {
+	CardStore
+
+	cache *cache.Cache
+}
+
+func (cs *cached) ModifiedCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error {
+	if err := cs.CardStore.ModifiedCard(ctx, cardDTO); err != nil {
+		return err
+	}
+
+	cs.cache.Invalidate(ctx, CACHE_NAMESPACE)
+	return nil
+}
+
+func (cs *cached) GetByCardID(ctx context.Context, ID string) (*cardDTO.CardDTO, error) {
+	return cache.Fetch(ctx, cs.cache, cache.Key(CACHE_NAMESPACE, "id", ID),
+		func(ctx context.Context) (*cardDTO.CardDTO, error) {
+			return cs.CardStore.GetByCardID(ctx, ID)
+		})
+}
+
+func (cs *cached) GetLatestCards(ctx context.Context, statuses []commonM.Status) ([]*cardDTO.CardDTO, error) {
+	return cache.Fetch(ctx, cs.cache, cache.Key(CACHE_NAMESPACE, "latest", statuses),
+		func(ctx context.Context) ([]*cardDTO.CardDTO, error) {
+			return cs.CardStore.GetLatestCards(ctx, statuses)
+		})
+}
@@ -11,7 +11,9 @@ import (
 	"go.uber.org/dig"
 
 	cardDTO "pickrewardapi/internal/domain/card/dto"
+	"pickrewardapi/internal/pkg/cache"
 	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
 	commonM "pickrewardapi/internal/shared/common/model"
 )
 
@@ -19,10 +21,12 @@ type CardStore interface {
 	ModifiedCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error
 
 	GetByCardID(ctx context.Context, ID string) (*cardDTO.CardDTO, error)
-	GetLatestCards(ctx context.Context) ([]*cardDTO.CardDTO, error)
+	GetLatestCards(ctx context.Context, statuses []commonM.Status) ([]*cardDTO.CardDTO, error)
 	GetAllCards(ctx context.Context) ([]*cardDTO.CardDTO, error)
-	GetCardsByBankID(ctx context.Context, bankID string, status commonM.Status) ([]*cardDTO.CardDTO, error)
-	SearchCard(ctx context.Context, keyword string, status commonM.Status) ([]*cardDTO.CardDTO, error)
+	// GetCardsByBankID and SearchCard return at most limit cards from offset
+	// and the count of all the matching cards.
+	GetCardsByBankID(ctx context.Context, bankID string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error)
+	SearchCard(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error)
 }
 
 type impl struct {
@@ -32,16 +36,19 @@ type impl struct {
 	migration *pgx.ConnPool
 }
 
-func New(sql *psql.Psql) CardStore {
+func New(sql *psql.Psql, c *cache.Cache) CardStore {
 	logPos := "[card.store][New]"
 
 	log.WithFields(log.Fields{
 		"pos": logPos,
 	}).Infof("init card store")
 
-	return &impl{
-		primary:   sql.Primary,
-		migration: sql.Migration,
+	return &cached{
+		CardStore: &impl{
+			primary:   sql.Primary,
+			migration: sql.Migration,
+		},
+		cache: c,
 	}
 }
 
@@ -61,7 +68,10 @@ var MODIFIED_CARD_STAT = fmt.Sprintf(
 func (im *impl) ModifiedCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error {
 	logPos := "[card.store][ModifiedCard]"
 
-	tx, err := im.primary.Begin()
+	ctx, span := tracing.StartQuery(ctx, "card.store.ModifiedCard", "MODIFIED_CARD_STAT")
+	defer span.End()
+
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -101,15 +111,15 @@ func (im *impl) ModifiedCard(ctx context.Context, cardDTO *cardDTO.CardDTO) erro
 		cardDTO.UpdateDate,
 	}
 
-	if _, err := tx.Exec(MODIFIED_CARD_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, MODIFIED_CARD_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
 			"card.ID": cardDTO.ID,
-		}).Errorf("tx.Exec failed: ", err)
+		}).Error("tx.Exec failed: ", err)
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -129,7 +139,10 @@ var INSERT_CARD_STAT = fmt.Sprintf(
 func (im *impl) CreateCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error {
 	logPos := "[card.store][CreateCard]"
 
-	tx, err := im.primary.Begin()
+	ctx, span := tracing.StartQuery(ctx, "card.store.CreateCard", "INSERT_CARD_STAT")
+	defer span.End()
+
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -160,7 +173,7 @@ func (im *impl) CreateCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error
 		cardDTO.UpdateDate,
 	}
 
-	if _, err := tx.Exec(INSERT_CARD_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, INSERT_CARD_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
 			"card.ID": cardDTO.ID,
@@ -168,7 +181,7 @@ func (im *impl) CreateCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -188,9 +201,12 @@ var SELECT_CARD_BY_ID_STAT = fmt.Sprintf(
 func (im *impl) GetByCardID(ctx context.Context, ID string) (*cardDTO.CardDTO, error) {
 	logPos := "[card.store][GetByCardID]"
 
+	ctx, span := tracing.StartQuery(ctx, "card.store.GetByCardID", "SELECT_CARD_BY_ID_STAT")
+	defer span.End()
+
 	var c *cardDTO.CardDTO
 
-	rows, err := im.primary.Query(SELECT_CARD_BY_ID_STAT, ID)
+	rows, err := im.primary.QueryEx(ctx, SELECT_CARD_BY_ID_STAT, nil, ID)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
@@ -237,25 +253,38 @@ func (im *impl) GetByCardID(ctx context.Context, ID string) (*cardDTO.CardDTO, e
 	return c, nil
 }
 
+const CARDS_BY_BANK_ID_WHERE = " WHERE \"bank_id\" = $1 " +
+	" AND \"card_status\" = ANY($2) "
+
 var SELECT_CARDS_BY_BANK_ID_STAT = fmt.Sprintf(
-	"SELECT %s FROM %s "+
-		" WHERE \"bank_id\" = $1 "+
-		" AND \"card_status\" = $2 ",
-	ALL_COLUMNS, CARD,
+	"SELECT %s, COUNT(*) OVER() FROM %s %s "+
+		" ORDER BY \"order\", \"id\" "+
+		" LIMIT $3 OFFSET $4 ",
+	ALL_COLUMNS, CARD, CARDS_BY_BANK_ID_WHERE,
+)
+
+var COUNT_CARDS_BY_BANK_ID_STAT = fmt.Sprintf(
+	"SELECT COUNT(*) FROM %s %s",
+	CARD, CARDS_BY_BANK_ID_WHERE,
 )
 
-func (im *impl) GetCardsByBankID(ctx context.Context, bankID string, status commonM.Status) ([]*cardDTO.CardDTO, error) {
+func (im *impl) GetCardsByBankID(ctx context.Context, bankID string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error) {
 	logPos := "[card.store][GetCardsByBankID]"
 
+	ctx, span := tracing.StartQuery(ctx, "card.store.GetCardsByBankID", "SELECT_CARDS_BY_BANK_ID_STAT")
+	defer span.End()
+
 	cardDTOs := []*cardDTO.CardDTO{}
 
-	rows, err := im.primary.Query(SELECT_CARDS_BY_BANK_ID_STAT, bankID, status)
+	var total int32
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_CARDS_BY_BANK_ID_STAT, nil, bankID, commonM.Int32s(statuses), limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":     logPos,
 			"bank.ID": bankID,
 		}).Error("psql.Query failed: ", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer rows.Close()
@@ -272,6 +301,7 @@ func (im *impl) GetCardsByBankID(ctx context.Context, bankID string, status comm
 			&cardDTO.CardStatus,
 			&cardDTO.CreateDate,
 			&cardDTO.UpdateDate,
+			&total,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
@@ -279,12 +309,24 @@ func (im *impl) GetCardsByBankID(ctx context.Context, bankID string, status comm
 				"pos":     logPos,
 				"bank.ID": bankID,
 			}).Error("rows.Scan failed: ", err)
-			return nil, err
+			return nil, 0, err
 		}
 		cardDTOs = append(cardDTOs, cardDTO)
 	}
 
-	return cardDTOs, nil
+	// a page past the last card has no row carrying the count
+	if len(cardDTOs) == 0 && offset > 0 {
+		total, err = im.count(ctx, "COUNT_CARDS_BY_BANK_ID_STAT", COUNT_CARDS_BY_BANK_ID_STAT, bankID, commonM.Int32s(statuses))
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos":     logPos,
+				"bank.ID": bankID,
+			}).Error("count failed: ", err)
+			return nil, 0, err
+		}
+	}
+
+	return cardDTOs, total, nil
 }
 
 var SELECT_ALL_CARDS_STAT = fmt.Sprintf(
@@ -296,9 +338,12 @@ func (im *impl) GetAllCards(ctx context.Context) ([]*cardDTO.CardDTO, error) {
 
 	logPos := "[card.store][GetAllCards]"
 
+	ctx, span := tracing.StartQuery(ctx, "card.store.GetAllCards", "SELECT_ALL_CARDS_STAT")
+	defer span.End()
+
 	cardDTOs := []*cardDTO.CardDTO{}
 
-	rows, err := im.primary.Query(SELECT_ALL_CARDS_STAT)
+	rows, err := im.primary.QueryEx(ctx, SELECT_ALL_CARDS_STAT, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -336,17 +381,20 @@ func (im *impl) GetAllCards(ctx context.Context) ([]*cardDTO.CardDTO, error) {
 
 var SELECT_LATEST_CARDS_STAT = fmt.Sprintf(
 	"SELECT %s FROM %s "+
-		" WHERE card_status = 2 order by update_date desc LIMIT 20 ",
+		" WHERE card_status = ANY($1) order by update_date desc, id ",
 	ALL_COLUMNS, CARD,
 )
 
-func (im *impl) GetLatestCards(ctx context.Context) ([]*cardDTO.CardDTO, error) {
+func (im *impl) GetLatestCards(ctx context.Context, statuses []commonM.Status) ([]*cardDTO.CardDTO, error) {
 
 	logPos := "[card.store][GetLatestCards]"
 
+	ctx, span := tracing.StartQuery(ctx, "card.store.GetLatestCards", "SELECT_LATEST_CARDS_STAT")
+	defer span.End()
+
 	cardDTOs := []*cardDTO.CardDTO{}
 
-	rows, err := im.primary.Query(SELECT_LATEST_CARDS_STAT)
+	rows, err := im.primary.QueryEx(ctx, SELECT_LATEST_CARDS_STAT, nil, commonM.Int32s(statuses))
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -382,19 +430,27 @@ func (im *impl) GetLatestCards(ctx context.Context) ([]*cardDTO.CardDTO, error)
 	return cardDTOs, nil
 }
 
+const CARDS_BY_KEYWORD_WHERE = " WHERE card_status = ANY($1) " +
+	" AND ( EXISTS (SELECT 1 FROM json_array_elements_text(card.descriptions) AS d WHERE d ~~* $2) " +
+	" OR name ~~* $3) "
+
 var SELECT_CARDS_BY_KEYWORD_STAT = fmt.Sprintf(
-	"SELECT DISTINCT on(id) %s "+
-		" FROM %s, "+
-		" LATERAL json_array_elements_text(card.descriptions) AS d "+
-		" WHERE card_status = $1 "+
-		" AND ( d ~~* $2 "+
-		" OR name ~~* $3) ",
+	"SELECT %s, COUNT(*) OVER() FROM %s %s "+
+		" ORDER BY \"order\", \"id\" "+
+		" LIMIT $4 OFFSET $5 ",
+	ALL_COLUMNS, CARD, CARDS_BY_KEYWORD_WHERE,
+)
 
-	ALL_COLUMNS, CARD,
+var COUNT_CARDS_BY_KEYWORD_STAT = fmt.Sprintf(
+	"SELECT COUNT(*) FROM %s %s",
+	CARD, CARDS_BY_KEYWORD_WHERE,
 )
 
-func (im *impl) SearchCard(ctx context.Context, keyword string, status commonM.Status) ([]*cardDTO.CardDTO, error) {
-	logPos := "[card.store][GetLatestCards]"
+func (im *impl) SearchCard(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error) {
+	logPos := "[card.store][SearchCard]"
+
+	ctx, span := tracing.StartQuery(ctx, "card.store.SearchCard", "SELECT_CARDS_BY_KEYWORD_STAT")
+	defer span.End()
 
 	cardDTOs := []*cardDTO.CardDTO{}
 
@@ -404,12 +460,14 @@ func (im *impl) SearchCard(ctx context.Context, keyword string, status commonM.S
 	builder.WriteString("%")
 	concatKeyword := builder.String()
 
-	rows, err := im.primary.Query(SELECT_CARDS_BY_KEYWORD_STAT, status, concatKeyword, concatKeyword)
+	var total int32
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_CARDS_BY_KEYWORD_STAT, nil, commonM.Int32s(statuses), concatKeyword, concatKeyword, limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("psql.Query failed: ", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer rows.Close()
@@ -426,16 +484,42 @@ func (im *impl) SearchCard(ctx context.Context, keyword string, status commonM.S
 			&cardDTO.CardStatus,
 			&cardDTO.CreateDate,
 			&cardDTO.UpdateDate,
+			&total,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
 			log.WithFields(log.Fields{
 				"pos": logPos,
 			}).Error("rows.Scan failed: ", err)
-			return nil, err
+			return nil, 0, err
 		}
 		cardDTOs = append(cardDTOs, cardDTO)
 	}
 
-	return cardDTOs, nil
+	// a page past the last card has no row carrying the count
+	if len(cardDTOs) == 0 && offset > 0 {
+		total, err = im.count(ctx, "COUNT_CARDS_BY_KEYWORD_STAT", COUNT_CARDS_BY_KEYWORD_STAT, commonM.Int32s(statuses), concatKeyword, concatKeyword)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("count failed: ", err)
+			return nil, 0, err
+		}
+	}
+
+	return cardDTOs, total, nil
+}
+
+// count runs the COUNT(*) stat named statName.
+func (im *impl) count(ctx context.Context, statName, stat string, args ...interface{}) (int32, error) {
+
+	ctx, span := tracing.StartQuery(ctx, "card.store.count", statName)
+	defer span.End()
+
+	var total int32
+	if err := im.primary.QueryRowEx(ctx, stat, nil, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
 }
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	"pickrewardapi/internal/pkg/memdb"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// memory is a CardStore kept in memory, used by the demo mode instead of
+// Postgres.
+type memory struct {
+	cards *memdb.Table[string, cardDTO.CardDTO]
+}
+
+func NewMemory() CardStore {
+	logPos := "[card.store][NewMemory]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Infof("init memory card store")
+
+	return &memory{
+		cards: memdb.NewTable[string, cardDTO.CardDTO](),
+	}
+}
+
+func (m *memory) ModifiedCard(ctx context.Context, cardDTO *cardDTO.CardDTO) error {
+	return m.cards.Put(cardDTO.ID, cardDTO)
+}
+
+func (m *memory) GetByCardID(ctx context.Context, ID string) (*cardDTO.CardDTO, error) {
+	return m.cards.Get(ID)
+}
+
+func (m *memory) GetLatestCards(ctx context.Context, statuses []commonM.Status) ([]*cardDTO.CardDTO, error) {
+	cards, err := m.cards.Select(func(c *cardDTO.CardDTO) bool {
+		return slices.Contains(statuses, c.CardStatus)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(cards, func(i, j int) bool {
+		if cards[i].UpdateDate != cards[j].UpdateDate {
+			return cards[i].UpdateDate > cards[j].UpdateDate
+		}
+		return cards[i].ID < cards[j].ID
+	})
+
+	return cards, nil
+}
+
+func (m *memory) GetAllCards(ctx context.Context) ([]*cardDTO.CardDTO, error) {
+	cards, err := m.cards.Select(memdb.All[cardDTO.CardDTO])
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(cards)
+	return cards, nil
+}
+
+func (m *memory) GetCardsByBankID(ctx context.Context, bankID string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error) {
+	cards, err := m.cards.Select(func(c *cardDTO.CardDTO) bool {
+		return c.BankID == bankID && slices.Contains(statuses, c.CardStatus)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortByOrder(cards)
+	cards, total := memdb.Limit(cards, offset, limit)
+	return cards, total, nil
+}
+
+// SearchCard matches the keyword case-insensitively in the name or any
+// description, as ~~* of SearchCard in Postgres.
+func (m *memory) SearchCard(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*cardDTO.CardDTO, int32, error) {
+	keyword = strings.ToLower(keyword)
+
+	cards, err := m.cards.Select(func(c *cardDTO.CardDTO) bool {
+		if !slices.Contains(statuses, c.CardStatus) {
+			return false
+		}
+
+		if strings.Contains(strings.ToLower(c.Name), keyword) {
+			return true
+		}
+
+		for _, d := range c.Descriptions {
+			if strings.Contains(strings.ToLower(d), keyword) {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortByOrder(cards)
+	cards, total := memdb.Limit(cards, offset, limit)
+	return cards, total, nil
+}
+
+func sortByOrder(cards []*cardDTO.CardDTO) {
+	sort.Slice(cards, func(i, j int) bool {
+		if cards[i].Order != cards[j].Order {
+			return cards[i].Order < cards[j].Order
+		}
+		return cards[i].ID < cards[j].ID
+	})
+}
@@ -0,0 +1,14 @@
+package dto
+
+// BankRewardsDTO groups rewards of a bank by its cards.
+type BankRewardsDTO struct {
+	BankID   string            `json:"bankID"`
+	BankName string            `json:"bankName"`
+	Cards    []*CardRewardsDTO `json:"cards"`
+}
+
+type CardRewardsDTO struct {
+	CardID   string       `json:"cardID"`
+	CardName string       `json:"cardName"`
+	Rewards  []*RewardDTO `json:"rewards"`
+}
@@ -4,17 +4,67 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
+
+	commonM "pickrewardapi/internal/shared/common/model"
 )
 
 type RewardDTO struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	RewardType int32  `json:"rewardType"`
+	ID          string          `json:"id"`
+	CardID      string          `json:"cardID"`
+	Name        string          `json:"name"`
+	Description json.RawMessage `json:"description"`
+	StartDate   int64           `json:"startDate"`
+	EndDate     int64           `json:"endDate"`
+	Currency    int32           `json:"currency"`
+	RewardType  int32           `json:"rewardType"`
+	Order       int32           `json:"order"`
+	Rule        *RewardRuleDTO  `json:"rule"`
+
+	RewardStatus commonM.Status `json:"rewardStatus"`
 
 	CreateDate int64 `json:"createDate"`
 	UpdateDate int64 `json:"updateDate"`
 }
 
+type CalculateType int32
+
+const (
+	Percentage CalculateType = iota // 百分比回饋
+	Fixed                           // 固定回饋
+)
+
+type PeriodType int32
+
+const (
+	MonthPeriod    PeriodType = iota // 每月
+	CampaignPeriod                   // 活動期間
+)
+
+// RewardRuleDTO is the machine readable part of a card reward, it decides
+// which events the reward applies to and how much it gives back.
+type RewardRuleDTO struct {
+	CalculateType CalculateType `json:"calculateType"`
+	Percentage    float64       `json:"percentage"`
+	Fixed         float64       `json:"fixed"`
+
+	// MinCost is the minimum spend of a transaction to get the reward and
+	// PeriodMinCost the minimum spend of the card in the period.
+	MinCost       int32 `json:"minCost"`
+	PeriodMinCost int32 `json:"periodMinCost"`
+	// TransactionCap and PeriodCap limit the reward given per transaction and
+	// per period, 0 means no limit.
+	TransactionCap float64    `json:"transactionCap"`
+	PeriodCap      float64    `json:"periodCap"`
+	PeriodType     PeriodType `json:"periodType"`
+
+	ChannelIDs    []string `json:"channelIDs"`
+	ChannelLabels []int32  `json:"channelLabels"`
+	PayIDs        []string `json:"payIDs"`
+
+	Constraints []*commonM.Constraint `json:"constraints"`
+}
+
 // Make the Attrs struct implement the driver.Valuer interface. This method
 // simply returns the JSON-encoded representation of the struct.
 func (a RewardDTO) Value() (driver.Value, error) {
@@ -31,3 +81,179 @@ func (a *RewardDTO) Scan(value interface{}) error {
 
 	return json.Unmarshal(b, &a)
 }
+
+// IsActiveAt reports whether date falls in the reward's start and end date,
+// an end date of 0 means the reward has no end.
+func (a *RewardDTO) IsActiveAt(date int64) bool {
+	if date < a.StartDate {
+		return false
+	}
+	if a.EndDate != 0 && date > a.EndDate {
+		return false
+	}
+	return true
+}
+
+// EndsWithin reports whether the reward is active at date and ends no later
+// than days after it.
+func (a *RewardDTO) EndsWithin(date int64, days int32) bool {
+	if !a.IsActiveAt(date) || a.EndDate == 0 {
+		return false
+	}
+	return a.EndDate <= date+int64(days)*secondsPerDay
+}
+
+// StartsWithin reports whether the reward has not started at date and starts
+// no later than days after it.
+func (a *RewardDTO) StartsWithin(date int64, days int32) bool {
+	if date >= a.StartDate {
+		return false
+	}
+	return a.StartDate <= date+int64(days)*secondsPerDay
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// Validate checks the reward can be stored and computed.
+func (a *RewardDTO) Validate() error {
+
+	if a.ID == "" {
+		return errors.New("reward id is empty")
+	}
+
+	if a.CardID == "" {
+		return errors.New("reward card id is empty")
+	}
+
+	if a.Name == "" {
+		return errors.New("reward name is empty")
+	}
+
+	if _, err := commonM.GetRewardType(a.RewardType); err != nil || commonM.RewardType(a.RewardType) == commonM.NONE_REWARD {
+		return fmt.Errorf("invalid reward type: %d", a.RewardType)
+	}
+
+	if _, err := commonM.GetCurrencyType(a.Currency); err != nil {
+		return fmt.Errorf("invalid currency: %d", a.Currency)
+	}
+
+	if _, err := commonM.GetStatus(int32(a.RewardStatus)); err != nil {
+		return fmt.Errorf("invalid reward status: %d", a.RewardStatus)
+	}
+
+	if a.EndDate != 0 && a.EndDate < a.StartDate {
+		return errors.New("reward end date is before start date")
+	}
+
+	if a.Rule == nil {
+		return errors.New("reward rule is empty")
+	}
+
+	return a.Rule.Validate()
+}
+
+// Validate checks the rule can be computed.
+func (r *RewardRuleDTO) Validate() error {
+
+	switch r.CalculateType {
+	case Percentage:
+		if r.Percentage <= 0 || r.Percentage > 100 {
+			return fmt.Errorf("invalid percentage: %v", r.Percentage)
+		}
+	case Fixed:
+		if r.Fixed <= 0 {
+			return fmt.Errorf("invalid fixed reward: %v", r.Fixed)
+		}
+	default:
+		return fmt.Errorf("invalid calculate type: %d", r.CalculateType)
+	}
+
+	if r.MinCost < 0 {
+		return fmt.Errorf("invalid min cost: %d", r.MinCost)
+	}
+
+	if r.PeriodMinCost < 0 {
+		return fmt.Errorf("invalid period min cost: %d", r.PeriodMinCost)
+	}
+
+	if r.TransactionCap < 0 {
+		return fmt.Errorf("invalid transaction cap: %v", r.TransactionCap)
+	}
+
+	if r.PeriodCap < 0 {
+		return fmt.Errorf("invalid period cap: %v", r.PeriodCap)
+	}
+
+	if r.PeriodType != MonthPeriod && r.PeriodType != CampaignPeriod {
+		return fmt.Errorf("invalid period type: %d", r.PeriodType)
+	}
+
+	for _, ID := range r.ChannelIDs {
+		if ID == "" {
+			return errors.New("channel id is empty")
+		}
+	}
+
+	for _, label := range r.ChannelLabels {
+		if _, err := commonM.GetLabel(label); err != nil {
+			return err
+		}
+	}
+
+	for _, ID := range r.PayIDs {
+		if ID == "" {
+			return errors.New("pay id is empty")
+		}
+	}
+
+	for _, c := range r.Constraints {
+		if c == nil {
+			return errors.New("constraint is nil")
+		}
+
+		if _, err := commonM.GetConstraintType(int32(c.ConstraintType)); err != nil {
+			return err
+		}
+
+		switch c.ConstraintType {
+		case commonM.LimitWeekDay:
+			if len(c.WeekDays) == 0 {
+				return errors.New("week days are empty")
+			}
+			for _, d := range c.WeekDays {
+				if d < 0 || d > 6 {
+					return fmt.Errorf("invalid week day: %d", d)
+				}
+			}
+		case commonM.LimitCount:
+			if c.LimitCount < 0 {
+				return fmt.Errorf("invalid limit count: %d", c.LimitCount)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Calculate returns what the rule gives back for cost before any period cap
+// is applied, and whether the transaction cap cut it.
+func (r *RewardRuleDTO) Calculate(cost int32) (float64, bool) {
+
+	if cost < r.MinCost {
+		return 0, false
+	}
+
+	var amount float64
+	switch r.CalculateType {
+	case Percentage:
+		amount = float64(cost) * r.Percentage / 100
+	case Fixed:
+		amount = r.Fixed
+	}
+
+	if r.TransactionCap > 0 && amount > r.TransactionCap {
+		return r.TransactionCap, true
+	}
+
+	return amount, false
+}
@@ -0,0 +1,229 @@
+package dto
+
+import (
+	"testing"
+
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+func TestRewardRuleCalculate(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		rule       *RewardRuleDTO
+		cost       int32
+		wantAmount float64
+		wantCapped bool
+	}{
+		{
+			name:       "percentage",
+			rule:       &RewardRuleDTO{CalculateType: Percentage, Percentage: 3},
+			cost:       1000,
+			wantAmount: 30,
+		},
+		{
+			name:       "fixed",
+			rule:       &RewardRuleDTO{CalculateType: Fixed, Fixed: 50},
+			cost:       1000,
+			wantAmount: 50,
+		},
+		{
+			name:       "percentage under the transaction cap",
+			rule:       &RewardRuleDTO{CalculateType: Percentage, Percentage: 3, TransactionCap: 100},
+			cost:       1000,
+			wantAmount: 30,
+		},
+		{
+			name:       "percentage cut by the transaction cap",
+			rule:       &RewardRuleDTO{CalculateType: Percentage, Percentage: 3, TransactionCap: 20},
+			cost:       1000,
+			wantAmount: 20,
+			wantCapped: true,
+		},
+		{
+			name:       "fixed cut by the transaction cap",
+			rule:       &RewardRuleDTO{CalculateType: Fixed, Fixed: 50, TransactionCap: 30},
+			cost:       1000,
+			wantAmount: 30,
+			wantCapped: true,
+		},
+		{
+			name:       "period cap is left to the evaluation",
+			rule:       &RewardRuleDTO{CalculateType: Percentage, Percentage: 3, PeriodCap: 10},
+			cost:       1000,
+			wantAmount: 30,
+		},
+		{
+			name:       "cost under the min cost",
+			rule:       &RewardRuleDTO{CalculateType: Fixed, Fixed: 50, MinCost: 1001},
+			cost:       1000,
+			wantAmount: 0,
+		},
+		{
+			name:       "cost at the min cost",
+			rule:       &RewardRuleDTO{CalculateType: Fixed, Fixed: 50, MinCost: 1000},
+			cost:       1000,
+			wantAmount: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, capped := tt.rule.Calculate(tt.cost)
+			if amount != tt.wantAmount || capped != tt.wantCapped {
+				t.Errorf("Calculate(%d) = %v, %v, want %v, %v", tt.cost, amount, capped, tt.wantAmount, tt.wantCapped)
+			}
+		})
+	}
+}
+
+func validRule() *RewardRuleDTO {
+	return &RewardRuleDTO{
+		CalculateType: Percentage,
+		Percentage:    3,
+		PeriodType:    MonthPeriod,
+		ChannelIDs:    []string{"channel"},
+		ChannelLabels: []int32{int32(commonM.Oversea)},
+		PayIDs:        []string{"pay"},
+		Constraints: []*commonM.Constraint{
+			{ConstraintType: commonM.LimitWeekDay, WeekDays: []int32{0, 6}},
+			{ConstraintType: commonM.LimitCount, LimitCount: 100},
+		},
+	}
+}
+
+func TestRewardRuleValidate(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		modify  func(r *RewardRuleDTO)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(r *RewardRuleDTO) {}},
+		{name: "valid fixed", modify: func(r *RewardRuleDTO) { r.CalculateType, r.Fixed = Fixed, 10 }},
+		{name: "zero percentage", modify: func(r *RewardRuleDTO) { r.Percentage = 0 }, wantErr: true},
+		{name: "percentage over 100", modify: func(r *RewardRuleDTO) { r.Percentage = 101 }, wantErr: true},
+		{name: "zero fixed", modify: func(r *RewardRuleDTO) { r.CalculateType, r.Fixed = Fixed, 0 }, wantErr: true},
+		{name: "unknown calculate type", modify: func(r *RewardRuleDTO) { r.CalculateType = 2 }, wantErr: true},
+		{name: "negative min cost", modify: func(r *RewardRuleDTO) { r.MinCost = -1 }, wantErr: true},
+		{name: "negative period min cost", modify: func(r *RewardRuleDTO) { r.PeriodMinCost = -1 }, wantErr: true},
+		{name: "negative transaction cap", modify: func(r *RewardRuleDTO) { r.TransactionCap = -1 }, wantErr: true},
+		{name: "negative period cap", modify: func(r *RewardRuleDTO) { r.PeriodCap = -1 }, wantErr: true},
+		{name: "unknown period type", modify: func(r *RewardRuleDTO) { r.PeriodType = 2 }, wantErr: true},
+		{name: "empty channel id", modify: func(r *RewardRuleDTO) { r.ChannelIDs = []string{""} }, wantErr: true},
+		{name: "unknown channel label", modify: func(r *RewardRuleDTO) { r.ChannelLabels = []int32{-1} }, wantErr: true},
+		{name: "empty pay id", modify: func(r *RewardRuleDTO) { r.PayIDs = []string{""} }, wantErr: true},
+		{name: "nil constraint", modify: func(r *RewardRuleDTO) { r.Constraints = []*commonM.Constraint{nil} }, wantErr: true},
+		{
+			name: "unknown constraint type",
+			modify: func(r *RewardRuleDTO) {
+				r.Constraints = []*commonM.Constraint{{ConstraintType: -1}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "week day constraint without week days",
+			modify: func(r *RewardRuleDTO) {
+				r.Constraints = []*commonM.Constraint{{ConstraintType: commonM.LimitWeekDay}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "week day out of range",
+			modify: func(r *RewardRuleDTO) {
+				r.Constraints = []*commonM.Constraint{{ConstraintType: commonM.LimitWeekDay, WeekDays: []int32{7}}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative limit count",
+			modify: func(r *RewardRuleDTO) {
+				r.Constraints = []*commonM.Constraint{{ConstraintType: commonM.LimitCount, LimitCount: -1}}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validRule()
+			tt.modify(r)
+
+			if err := r.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRewardValidate(t *testing.T) {
+
+	valid := func() *RewardDTO {
+		return &RewardDTO{
+			ID:           "reward",
+			CardID:       "card",
+			Name:         "reward",
+			StartDate:    100,
+			EndDate:      200,
+			Currency:     int32(commonM.TWD),
+			RewardType:   int32(commonM.CASH),
+			Rule:         validRule(),
+			RewardStatus: commonM.Active,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *RewardDTO)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(r *RewardDTO) {}},
+		{name: "no end date", modify: func(r *RewardDTO) { r.EndDate = 0 }},
+		{name: "empty id", modify: func(r *RewardDTO) { r.ID = "" }, wantErr: true},
+		{name: "empty card id", modify: func(r *RewardDTO) { r.CardID = "" }, wantErr: true},
+		{name: "empty name", modify: func(r *RewardDTO) { r.Name = "" }, wantErr: true},
+		{name: "no reward type", modify: func(r *RewardDTO) { r.RewardType = int32(commonM.NONE_REWARD) }, wantErr: true},
+		{name: "unknown currency", modify: func(r *RewardDTO) { r.Currency = -1 }, wantErr: true},
+		{name: "unknown status", modify: func(r *RewardDTO) { r.RewardStatus = -1 }, wantErr: true},
+		{name: "end before start", modify: func(r *RewardDTO) { r.EndDate = 99 }, wantErr: true},
+		{name: "no rule", modify: func(r *RewardDTO) { r.Rule = nil }, wantErr: true},
+		{name: "invalid rule", modify: func(r *RewardDTO) { r.Rule.Percentage = 0 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.modify(r)
+
+			if err := r.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRewardIsActiveAt(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		startDate int64
+		endDate   int64
+		date      int64
+		want      bool
+	}{
+		{name: "before start", startDate: 100, endDate: 200, date: 99, want: false},
+		{name: "at start", startDate: 100, endDate: 200, date: 100, want: true},
+		{name: "at end", startDate: 100, endDate: 200, date: 200, want: true},
+		{name: "after end", startDate: 100, endDate: 200, date: 201, want: false},
+		{name: "no end", startDate: 100, endDate: 0, date: 1 << 40, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RewardDTO{StartDate: tt.startDate, EndDate: tt.endDate}
+			if got := r.IsActiveAt(tt.date); got != tt.want {
+				t.Errorf("IsActiveAt(%d) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
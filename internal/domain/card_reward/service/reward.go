@@ -2,40 +2,469 @@ package service
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+
+	baseCtx "pickrewardapi/base/ctx"
+
 	cardRewardDTO "pickrewardapi/internal/domain/card_reward/dto"
 
+	bankStore "pickrewardapi/internal/domain/bank/store"
+	cardStore "pickrewardapi/internal/domain/card/store"
 	cardRewardStore "pickrewardapi/internal/domain/card_reward/store"
+	"pickrewardapi/internal/shared/common/errs"
+	commonM "pickrewardapi/internal/shared/common/model"
+	"pickrewardapi/internal/shared/common/page"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
 
 	"go.uber.org/dig"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type RewardAppService interface {
 	GetRewardByID(ctx context.Context, ID string) (*cardRewardDTO.RewardDTO, error)
+	GetRewardsByCardID(ctx context.Context, cardID string, includeOutOfWindow bool, req page.Request) ([]*cardRewardDTO.RewardDTO, *page.Info, error)
+	GetActiveRewardsByCardID(ctx context.Context, cardID string, date int64, req page.Request) ([]*cardRewardDTO.RewardDTO, *page.Info, error)
+	GetExpiringRewards(ctx context.Context, date int64, days int32) ([]*cardRewardDTO.BankRewardsDTO, error)
+	GetUpcomingRewards(ctx context.Context, date int64, days int32) ([]*cardRewardDTO.BankRewardsDTO, error)
+
+	CreateReward(ctx context.Context, rewardDTO *cardRewardDTO.RewardDTO) (*cardRewardDTO.RewardDTO, error)
+	UpdateReward(ctx context.Context, rewardDTO *cardRewardDTO.RewardDTO) (*cardRewardDTO.RewardDTO, error)
+	UpdateRewardStatus(ctx context.Context, ID string, status commonM.Status) (*cardRewardDTO.RewardDTO, error)
 }
 
 type impl struct {
 	dig.In
 
 	rewardStore cardRewardStore.RewardStore
+	cardStore   cardStore.CardStore
+	bankStore   bankStore.BankStore
 }
 
 var (
 	timeNow = time.Now
 )
 
+// maxWindowDays bounds the days of expiring and upcoming listings.
+const maxWindowDays = 365
+
 func New(
 	rewardStore cardRewardStore.RewardStore,
+	cardStore cardStore.CardStore,
+	bankStore bankStore.BankStore,
 ) RewardAppService {
 
 	impl := &impl{
 		rewardStore: rewardStore,
+		cardStore:   cardStore,
+		bankStore:   bankStore,
 	}
 
 	return impl
 }
 
 func (im *impl) GetRewardByID(ctx context.Context, ID string) (*cardRewardDTO.RewardDTO, error) {
-	return im.rewardStore.GetRewardByID(ctx, ID)
+	logPos := "[card_reward.service][GetRewardByID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.GetRewardByID")
+	defer span.End()
+
+	reward, err := im.rewardStore.GetRewardByID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": ID,
+		}).Error("rewardStore.GetRewardByID failed: ", err)
+		return nil, err
+	}
+
+	if reward == nil || !preview.Visible(ctx, reward.RewardStatus) {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": ID,
+		}).Error("Cannot find rewardID")
+		return nil, errs.NewNotFound("Cannot find rewardID")
+	}
+
+	return reward, nil
+}
+
+func (im *impl) GetRewardsByCardID(ctx context.Context, cardID string, includeOutOfWindow bool, req page.Request) ([]*cardRewardDTO.RewardDTO, *page.Info, error) {
+	logPos := "[card_reward.service][GetRewardsByCardID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.GetRewardsByCardID")
+	defer span.End()
+
+	var (
+		rewards []*cardRewardDTO.RewardDTO
+		err     error
+	)
+	if includeOutOfWindow {
+		rewards, err = im.visibleRewards(ctx, cardID)
+	} else {
+		rewards, err = im.activeRewards(ctx, cardID, 0)
+	}
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":    logPos,
+			"cardID": cardID,
+		}).Error("rewardStore.GetRewardsByCardID failed: ", err)
+		return nil, nil, err
+	}
+
+	return page.Slice(rewards, req, "rewards.card", cardID, includeOutOfWindow, preview.Statuses(ctx))
+}
+
+func (im *impl) GetActiveRewardsByCardID(ctx context.Context, cardID string, date int64, req page.Request) ([]*cardRewardDTO.RewardDTO, *page.Info, error) {
+	logPos := "[card_reward.service][GetActiveRewardsByCardID]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.GetActiveRewardsByCardID")
+	defer span.End()
+
+	rewards, err := im.activeRewards(ctx, cardID, date)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":    logPos,
+			"cardID": cardID,
+		}).Error("rewardStore.GetRewardsByCardID failed: ", err)
+		return nil, nil, err
+	}
+
+	return page.Slice(rewards, req, "rewards.active", cardID, date, preview.Statuses(ctx))
+}
+
+// activeRewards returns the active rewards of the card in their window at
+// date, now when date is 0, and the pilot ones for testers.
+func (im *impl) activeRewards(ctx context.Context, cardID string, date int64) ([]*cardRewardDTO.RewardDTO, error) {
+
+	if date == 0 {
+		date = timeNow().Unix()
+	}
+
+	rewards, err := im.visibleRewards(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	activeRewards := []*cardRewardDTO.RewardDTO{}
+	for _, r := range rewards {
+		if r.IsActiveAt(date) {
+			activeRewards = append(activeRewards, r)
+		}
+	}
+
+	return activeRewards, nil
+}
+
+// visibleRewards returns the rewards of the card whose status the caller may
+// read, see preview.Statuses.
+func (im *impl) visibleRewards(ctx context.Context, cardID string) ([]*cardRewardDTO.RewardDTO, error) {
+
+	rewards, err := im.rewardStore.GetRewardsByCardID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleRewards := []*cardRewardDTO.RewardDTO{}
+	for _, r := range rewards {
+		if preview.Visible(ctx, r.RewardStatus) {
+			visibleRewards = append(visibleRewards, r)
+		}
+	}
+
+	return visibleRewards, nil
+}
+
+func (im *impl) GetExpiringRewards(ctx context.Context, date int64, days int32) ([]*cardRewardDTO.BankRewardsDTO, error) {
+	logPos := "[card_reward.service][GetExpiringRewards]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.GetExpiringRewards")
+	defer span.End()
+
+	if err := validateWindowDays(days); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":  logPos,
+			"days": days,
+		}).Error("validateWindowDays failed: ", err)
+		return nil, err
+	}
+
+	if date == 0 {
+		date = timeNow().Unix()
+	}
+
+	bankRewards, err := im.groupRewards(ctx, func(r *cardRewardDTO.RewardDTO) bool {
+		return r.EndsWithin(date, days)
+	}, func(a, b *cardRewardDTO.RewardDTO) bool {
+		return a.EndDate < b.EndDate
+	})
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("groupRewards failed: ", err)
+		return nil, err
+	}
+
+	return bankRewards, nil
+}
+
+func (im *impl) GetUpcomingRewards(ctx context.Context, date int64, days int32) ([]*cardRewardDTO.BankRewardsDTO, error) {
+	logPos := "[card_reward.service][GetUpcomingRewards]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.GetUpcomingRewards")
+	defer span.End()
+
+	if err := validateWindowDays(days); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":  logPos,
+			"days": days,
+		}).Error("validateWindowDays failed: ", err)
+		return nil, err
+	}
+
+	if date == 0 {
+		date = timeNow().Unix()
+	}
+
+	bankRewards, err := im.groupRewards(ctx, func(r *cardRewardDTO.RewardDTO) bool {
+		return r.StartsWithin(date, days)
+	}, func(a, b *cardRewardDTO.RewardDTO) bool {
+		return a.StartDate < b.StartDate
+	})
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("groupRewards failed: ", err)
+		return nil, err
+	}
+
+	return bankRewards, nil
+}
+
+func validateWindowDays(days int32) error {
+	if days <= 0 || days > maxWindowDays {
+		return errs.NewInvalidArgument("days must be between 1 and %d", maxWindowDays)
+	}
+	return nil
+}
+
+// groupRewards groups the rewards matched of the cards the call reads, see
+// preview.Statuses, by bank and card. Banks and cards keep their order and
+// rewards of a card are sorted by less.
+func (im *impl) groupRewards(
+	ctx context.Context,
+	match func(r *cardRewardDTO.RewardDTO) bool,
+	less func(a, b *cardRewardDTO.RewardDTO) bool,
+) ([]*cardRewardDTO.BankRewardsDTO, error) {
+	logPos := "[card_reward.service][groupRewards]"
+
+	banks, err := im.bankStore.GetAllBanks(ctx, preview.Statuses(ctx))
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("bankStore.GetAllBanks failed: ", err)
+		return nil, err
+	}
+
+	cards, err := im.cardStore.GetAllCards(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardStore.GetAllCards failed: ", err)
+		return nil, err
+	}
+
+	rewards, err := im.rewardStore.GetAllRewards(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rewardStore.GetAllRewards failed: ", err)
+		return nil, err
+	}
+
+	rewardsByCardID := map[string][]*cardRewardDTO.RewardDTO{}
+	for _, r := range rewards {
+		if preview.Visible(ctx, r.RewardStatus) && match(r) {
+			rewardsByCardID[r.CardID] = append(rewardsByCardID[r.CardID], r)
+		}
+	}
+
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].Order < cards[j].Order
+	})
+
+	cardsByBankID := map[string][]*cardRewardDTO.CardRewardsDTO{}
+	for _, c := range cards {
+		if !preview.Visible(ctx, c.CardStatus) {
+			continue
+		}
+
+		cardRewards := rewardsByCardID[c.ID]
+		if len(cardRewards) == 0 {
+			continue
+		}
+
+		sort.SliceStable(cardRewards, func(i, j int) bool {
+			return less(cardRewards[i], cardRewards[j])
+		})
+
+		cardsByBankID[c.BankID] = append(cardsByBankID[c.BankID], &cardRewardDTO.CardRewardsDTO{
+			CardID:   c.ID,
+			CardName: c.Name,
+			Rewards:  cardRewards,
+		})
+	}
+
+	sort.SliceStable(banks, func(i, j int) bool {
+		return banks[i].Order < banks[j].Order
+	})
+
+	bankRewards := []*cardRewardDTO.BankRewardsDTO{}
+	for _, b := range banks {
+		bankCards := cardsByBankID[b.ID]
+		if len(bankCards) == 0 {
+			continue
+		}
+
+		bankRewards = append(bankRewards, &cardRewardDTO.BankRewardsDTO{
+			BankID:   b.ID,
+			BankName: b.Name,
+			Cards:    bankCards,
+		})
+	}
+
+	return bankRewards, nil
+}
+
+func (im *impl) CreateReward(ctx context.Context, reward *cardRewardDTO.RewardDTO) (*cardRewardDTO.RewardDTO, error) {
+	logPos := "[card_reward.service][CreateReward]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.CreateReward")
+	defer span.End()
+
+	now := timeNow().Unix()
+	reward.ID = commonS.GenUUID()
+	reward.CreateDate = now
+	reward.UpdateDate = now
+
+	if err := im.validateReward(ctx, reward); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validateReward failed: ", err)
+		return nil, err
+	}
+
+	if err := im.rewardStore.ModifiedReward(ctx, reward); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": reward.ID,
+		}).Error("rewardStore.ModifiedReward failed: ", err)
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (im *impl) UpdateReward(ctx context.Context, reward *cardRewardDTO.RewardDTO) (*cardRewardDTO.RewardDTO, error) {
+	logPos := "[card_reward.service][UpdateReward]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.UpdateReward")
+	defer span.End()
+
+	existing, err := im.rewardStore.GetRewardByID(ctx, reward.ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": reward.ID,
+		}).Error("rewardStore.GetRewardByID failed: ", err)
+		return nil, err
+	}
+
+	if existing == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": reward.ID,
+		}).Error("Cannot find rewardID")
+		return nil, errs.NewNotFound("Cannot find rewardID")
+	}
+
+	reward.CreateDate = existing.CreateDate
+	reward.UpdateDate = timeNow().Unix()
+
+	if err := im.validateReward(ctx, reward); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": reward.ID,
+		}).Error("validateReward failed: ", err)
+		return nil, err
+	}
+
+	if err := im.rewardStore.ModifiedReward(ctx, reward); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": reward.ID,
+		}).Error("rewardStore.ModifiedReward failed: ", err)
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (im *impl) UpdateRewardStatus(ctx context.Context, ID string, status commonM.Status) (*cardRewardDTO.RewardDTO, error) {
+	logPos := "[card_reward.service][UpdateRewardStatus]"
+
+	ctx, span := tracing.Start(ctx, "card_reward.service.UpdateRewardStatus")
+	defer span.End()
+
+	reward, err := im.rewardStore.GetRewardByID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": ID,
+		}).Error("rewardStore.GetRewardByID failed: ", err)
+		return nil, err
+	}
+
+	if reward == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": ID,
+		}).Error("Cannot find rewardID")
+		return nil, errs.NewNotFound("Cannot find rewardID")
+	}
+
+	reward.RewardStatus = status
+	reward.UpdateDate = timeNow().Unix()
+
+	if err := im.rewardStore.ModifiedReward(ctx, reward); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":      logPos,
+			"rewardID": ID,
+		}).Error("rewardStore.ModifiedReward failed: ", err)
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (im *impl) validateReward(ctx context.Context, reward *cardRewardDTO.RewardDTO) error {
+
+	if err := reward.Validate(); err != nil {
+		return errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	card, err := im.cardStore.GetByCardID(ctx, reward.CardID)
+	if err != nil {
+		return err
+	}
+
+	if card == nil {
+		return errs.NewNotFound("Cannot find cardID")
+	}
+
+	return nil
 }
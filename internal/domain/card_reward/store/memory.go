@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+
+	log "github.com/sirupsen/logrus"
+
+	"pickrewardapi/internal/pkg/memdb"
+	"pickrewardapi/internal/shared/common/errs"
+)
+
+// memory is a RewardStore kept in memory, used by the demo mode instead of
+// Postgres.
+type memory struct {
+	rewards *memdb.Table[string, rewardDTO.RewardDTO]
+}
+
+func NewMemory() RewardStore {
+	logPos := "[reward.store][NewMemory]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Info("init memory reward store")
+
+	return &memory{
+		rewards: memdb.NewTable[string, rewardDTO.RewardDTO](),
+	}
+}
+
+func (m *memory) ModifiedReward(ctx context.Context, rewardDTO *rewardDTO.RewardDTO) error {
+	if err := rewardDTO.Validate(); err != nil {
+		return errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	return m.rewards.Put(rewardDTO.ID, rewardDTO)
+}
+
+func (m *memory) GetAllRewards(ctx context.Context) ([]*rewardDTO.RewardDTO, error) {
+	rewards, err := m.rewards.Select(memdb.All[rewardDTO.RewardDTO])
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(rewards)
+	return rewards, nil
+}
+
+func (m *memory) GetRewardByID(ctx context.Context, ID string) (*rewardDTO.RewardDTO, error) {
+	return m.rewards.Get(ID)
+}
+
+func (m *memory) GetRewardsByCardID(ctx context.Context, cardID string) ([]*rewardDTO.RewardDTO, error) {
+	rewards, err := m.rewards.Select(func(r *rewardDTO.RewardDTO) bool {
+		return r.CardID == cardID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(rewards)
+	return rewards, nil
+}
+
+func sortByOrder(rewards []*rewardDTO.RewardDTO) {
+	sort.Slice(rewards, func(i, j int) bool {
+		if rewards[i].Order != rewards[j].Order {
+			return rewards[i].Order < rewards[j].Order
+		}
+		return rewards[i].ID < rewards[j].ID
+	})
+}
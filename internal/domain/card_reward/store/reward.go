@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 
 	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
 
@@ -12,6 +13,8 @@ import (
 	"go.uber.org/dig"
 
 	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
+	"pickrewardapi/internal/shared/common/errs"
 )
 
 type RewardStore interface {
@@ -22,7 +25,7 @@ type RewardStore interface {
 
 	GetAllRewards(ctx context.Context) ([]*rewardDTO.RewardDTO, error)
 	GetRewardByID(ctx context.Context, ID string) (*rewardDTO.RewardDTO, error)
-	MigrateReward(ctx context.Context, rewardDTO *rewardDTO.RewardDTO) error
+	GetRewardsByCardID(ctx context.Context, cardID string) ([]*rewardDTO.RewardDTO, error)
 }
 
 type impl struct {
@@ -45,16 +48,35 @@ func New(sql *psql.Psql) RewardStore {
 	}
 }
 
-const MODIFIED_REWARD_STAT = "INSERT INTO reward " +
-	"(\"id\", \"name\", \"reward_type\", \"create_date\", \"update_date\") " +
-	" VALUES ($1, $2, $3, $4, $5) " +
-	" ON CONFLICT(id) DO UPDATE SET " +
-	" \"name\" = $6, \"reward_type\" = $7, \"create_date\" = $8, \"update_date\" = $9 "
+const CARD_REWARD = "card_reward"
+const ALL_COLUMNS = " \"id\", \"card_id\", \"name\", \"description\", \"start_date\", \"end_date\", " +
+	" \"currency\", \"reward_type\", \"order\", \"rule\", \"reward_status\", \"create_date\", \"update_date\" "
+
+var MODIFIED_REWARD_STAT = fmt.Sprintf(
+	"INSERT INTO %s (%s) "+
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) "+
+		" ON CONFLICT(id) DO UPDATE SET "+
+		" \"card_id\" = $14, \"name\" = $15, \"description\" = $16, \"start_date\" = $17, \"end_date\" = $18, "+
+		" \"currency\" = $19, \"reward_type\" = $20, \"order\" = $21, \"rule\" = $22, "+
+		" \"reward_status\" = $23, \"create_date\" = $24, \"update_date\" = $25 ",
+	CARD_REWARD, ALL_COLUMNS,
+)
 
 func (im *impl) ModifiedReward(ctx context.Context, rewardDTO *rewardDTO.RewardDTO) error {
-	logPos := "[reward.store][CreateReward]"
+	logPos := "[reward.store][ModifiedReward]"
+
+	ctx, span := tracing.StartQuery(ctx, "card_reward.store.ModifiedReward", "MODIFIED_REWARD_STAT")
+	defer span.End()
+
+	if err := rewardDTO.Validate(); err != nil {
+		log.WithFields(log.Fields{
+			"pos":       logPos,
+			"reward.ID": rewardDTO.ID,
+		}).Error("rewardDTO.Validate failed: ", err)
+		return errs.Wrap(errs.InvalidArgument, err)
+	}
 
-	tx, err := im.primary.Begin()
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -74,25 +96,42 @@ func (im *impl) ModifiedReward(ctx context.Context, rewardDTO *rewardDTO.RewardD
 
 	updater := []interface{}{
 		rewardDTO.ID,
+		rewardDTO.CardID,
 		rewardDTO.Name,
+		rewardDTO.Description,
+		rewardDTO.StartDate,
+		rewardDTO.EndDate,
+		rewardDTO.Currency,
 		rewardDTO.RewardType,
+		rewardDTO.Order,
+		rewardDTO.Rule,
+		rewardDTO.RewardStatus,
 		rewardDTO.CreateDate,
 		rewardDTO.UpdateDate,
 
+		rewardDTO.CardID,
 		rewardDTO.Name,
+		rewardDTO.Description,
+		rewardDTO.StartDate,
+		rewardDTO.EndDate,
+		rewardDTO.Currency,
 		rewardDTO.RewardType,
+		rewardDTO.Order,
+		rewardDTO.Rule,
+		rewardDTO.RewardStatus,
 		rewardDTO.CreateDate,
 		rewardDTO.UpdateDate,
 	}
 
-	if _, err := tx.Exec(MODIFIED_REWARD_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, MODIFIED_REWARD_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
-			"pos": logPos,
+			"pos":       logPos,
+			"reward.ID": rewardDTO.ID,
 		}).Error("tx.Exec failed: ", err)
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -102,15 +141,20 @@ func (im *impl) ModifiedReward(ctx context.Context, rewardDTO *rewardDTO.RewardD
 	return nil
 }
 
-const SELECT_ALL_REWARDS_STAT = "SELECT \"id\", \"name\", \"reward_type\", create_date, update_date " +
-	" FROM reward "
+var SELECT_ALL_REWARDS_STAT = fmt.Sprintf(
+	"SELECT %s FROM %s ",
+	ALL_COLUMNS, CARD_REWARD,
+)
 
 func (im *impl) GetAllRewards(ctx context.Context) ([]*rewardDTO.RewardDTO, error) {
 	logPos := "[reward.store][GetAllRewards]"
 
+	ctx, span := tracing.StartQuery(ctx, "card_reward.store.GetAllRewards", "SELECT_ALL_REWARDS_STAT")
+	defer span.End()
+
 	rewardDTOs := []*rewardDTO.RewardDTO{}
 
-	rows, err := im.primary.Query(SELECT_ALL_REWARDS_STAT)
+	rows, err := im.primary.QueryEx(ctx, SELECT_ALL_REWARDS_STAT, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -124,8 +168,16 @@ func (im *impl) GetAllRewards(ctx context.Context) ([]*rewardDTO.RewardDTO, erro
 		rewardDTO := &rewardDTO.RewardDTO{}
 		selector := []interface{}{
 			&rewardDTO.ID,
+			&rewardDTO.CardID,
 			&rewardDTO.Name,
+			&rewardDTO.Description,
+			&rewardDTO.StartDate,
+			&rewardDTO.EndDate,
+			&rewardDTO.Currency,
 			&rewardDTO.RewardType,
+			&rewardDTO.Order,
+			&rewardDTO.Rule,
+			&rewardDTO.RewardStatus,
 			&rewardDTO.CreateDate,
 			&rewardDTO.UpdateDate,
 		}
@@ -142,16 +194,21 @@ func (im *impl) GetAllRewards(ctx context.Context) ([]*rewardDTO.RewardDTO, erro
 	return rewardDTOs, nil
 }
 
-const SELECT_REWARD_BY_ID_STAT = "SELECT \"id\", \"name\", \"reward_type\", create_date, update_date " +
-	" FROM reward " +
-	" WHERE \"id\" = $1 "
+var SELECT_REWARD_BY_ID_STAT = fmt.Sprintf(
+	"SELECT %s FROM %s "+
+		" WHERE \"id\" = $1 ",
+	ALL_COLUMNS, CARD_REWARD,
+)
 
 func (im *impl) GetRewardByID(ctx context.Context, ID string) (*rewardDTO.RewardDTO, error) {
-	logPos := "[point.store][GetPointByID]"
+	logPos := "[reward.store][GetRewardByID]"
+
+	ctx, span := tracing.StartQuery(ctx, "card_reward.store.GetRewardByID", "SELECT_REWARD_BY_ID_STAT")
+	defer span.End()
 
 	var r *rewardDTO.RewardDTO
 
-	rows, err := im.primary.Query(SELECT_REWARD_BY_ID_STAT, ID)
+	rows, err := im.primary.QueryEx(ctx, SELECT_REWARD_BY_ID_STAT, nil, ID)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -166,8 +223,16 @@ func (im *impl) GetRewardByID(ctx context.Context, ID string) (*rewardDTO.Reward
 		r = &rewardDTO.RewardDTO{}
 		selector := []interface{}{
 			&r.ID,
+			&r.CardID,
 			&r.Name,
+			&r.Description,
+			&r.StartDate,
+			&r.EndDate,
+			&r.Currency,
 			&r.RewardType,
+			&r.Order,
+			&r.Rule,
+			&r.RewardStatus,
 			&r.CreateDate,
 			&r.UpdateDate,
 		}
@@ -191,60 +256,59 @@ func (im *impl) GetRewardByID(ctx context.Context, ID string) (*rewardDTO.Reward
 	return r, nil
 }
 
-const MIGRATION_REWARD_STAT = "INSERT INTO reward " +
-	"(\"id\", \"name\", \"reward_type\", \"create_date\", \"update_date\") " +
-	" VALUES ($1, $2, $3, $4, $5) " +
-	" ON CONFLICT(id) DO UPDATE SET " +
-	" \"name\" = $6, \"reward_type\" = $7, \"create_date\" = $8, \"update_date\" = $9 "
+var SELECT_REWARDS_BY_CARD_ID_STAT = fmt.Sprintf(
+	"SELECT %s FROM %s "+
+		" WHERE \"card_id\" = $1 "+
+		" ORDER BY \"order\", \"id\" ",
+	ALL_COLUMNS, CARD_REWARD,
+)
+
+func (im *impl) GetRewardsByCardID(ctx context.Context, cardID string) ([]*rewardDTO.RewardDTO, error) {
+	logPos := "[reward.store][GetRewardsByCardID]"
 
-func (im *impl) MigrateReward(ctx context.Context, rewardDTO *rewardDTO.RewardDTO) error {
+	ctx, span := tracing.StartQuery(ctx, "card_reward.store.GetRewardsByCardID", "SELECT_REWARDS_BY_CARD_ID_STAT")
+	defer span.End()
 
-	logPos := "[reward.store][MigrateReward]"
+	rewardDTOs := []*rewardDTO.RewardDTO{}
 
-	tx, err := im.migration.Begin()
+	rows, err := im.primary.QueryEx(ctx, SELECT_REWARDS_BY_CARD_ID_STAT, nil, cardID)
 	if err != nil {
 		log.WithFields(log.Fields{
-			"pos": logPos,
-		}).Error("psql.Begin failed: ", err)
-		return err
+			"pos":     logPos,
+			"card.ID": cardID,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
 	}
 
-	defer func() {
-		if err != nil {
-			if rbErr := tx.Rollback(); rbErr != nil {
-				log.WithFields(log.Fields{
-					"pos": logPos,
-				}).Error("tx.Rollback failed: ", err)
-			}
-		}
-	}()
-
-	updater := []interface{}{
-		rewardDTO.ID,
-		rewardDTO.Name,
-		rewardDTO.RewardType,
-		rewardDTO.CreateDate,
-		rewardDTO.UpdateDate,
-
-		rewardDTO.Name,
-		rewardDTO.RewardType,
-		rewardDTO.CreateDate,
-		rewardDTO.UpdateDate,
-	}
+	defer rows.Close()
 
-	if _, err := tx.Exec(MIGRATION_REWARD_STAT, updater...); err != nil {
-		log.WithFields(log.Fields{
-			"pos": logPos,
-		}).Error("tx.Exec failed: ", err)
-		return err
-	}
+	for rows.Next() {
+		rewardDTO := &rewardDTO.RewardDTO{}
+		selector := []interface{}{
+			&rewardDTO.ID,
+			&rewardDTO.CardID,
+			&rewardDTO.Name,
+			&rewardDTO.Description,
+			&rewardDTO.StartDate,
+			&rewardDTO.EndDate,
+			&rewardDTO.Currency,
+			&rewardDTO.RewardType,
+			&rewardDTO.Order,
+			&rewardDTO.Rule,
+			&rewardDTO.RewardStatus,
+			&rewardDTO.CreateDate,
+			&rewardDTO.UpdateDate,
+		}
 
-	if err := tx.Commit(); err != nil {
-		log.WithFields(log.Fields{
-			"pos": logPos,
-		}).Error("tx.Commit failed: ", err)
-		return err
+		if err := rows.Scan(selector...); err != nil {
+			log.WithFields(log.Fields{
+				"pos":     logPos,
+				"card.ID": cardID,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		rewardDTOs = append(rewardDTOs, rewardDTO)
 	}
 
-	return nil
+	return rewardDTOs, nil
 }
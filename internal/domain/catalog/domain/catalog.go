@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	channelLabelDTO "pickrewardapi/internal/domain/channel_label/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	CSV  Format = "csv"
+)
+
+// FormatFromPath guesses the format of a catalog file by its extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return YAML, nil
+	case ".csv":
+		return CSV, nil
+	}
+	return "", fmt.Errorf("Cannot find catalog format of %s", path)
+}
+
+// Parse reads a catalog. JSON and YAML files hold a CatalogDTO document, CSV
+// files hold one entity per row and its kind in the kind column, see
+// parseCSV for the columns.
+func Parse(format Format, r io.Reader) (*catalogDTO.CatalogDTO, error) {
+	switch format {
+	case JSON:
+		catalog := &catalogDTO.CatalogDTO{}
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(catalog); err != nil {
+			return nil, err
+		}
+		return catalog, nil
+
+	case YAML:
+		var doc interface{}
+		if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		// Go through JSON so YAML keys follow the json tags of the DTOs.
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		catalog := &catalogDTO.CatalogDTO{}
+		if err := json.Unmarshal(b, catalog); err != nil {
+			return nil, err
+		}
+		return catalog, nil
+
+	case CSV:
+		return parseCSV(r)
+	}
+
+	return nil, fmt.Errorf("Cannot find catalog format %s", format)
+}
+
+// ParseFile reads the catalog file at path, its format is guessed from the
+// extension when format is empty.
+func ParseFile(path string, format Format) (*catalogDTO.CatalogDTO, error) {
+
+	if format == "" {
+		f, err := FormatFromPath(path)
+		if err != nil {
+			return nil, err
+		}
+		format = f
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(format, f)
+}
+
+const (
+	BANK          = "bank"
+	CARD          = "card"
+	CHANNEL       = "channel"
+	CHANNEL_LABEL = "channel_label"
+	REWARD        = "reward"
+)
+
+// parseCSV reads rows with the header
+//
+//	kind,id,name,order,status,bankID,cardID,linkURL,descriptions,channelType,
+//	channelLabels,label,show,description,startDate,endDate,currency,rewardType,rule
+//
+// only the kind column is required and columns not used by the kind of a row
+// are left empty. List columns are separated by "|", description and rule
+// are JSON documents.
+func parseCSV(r io.Reader) (*catalogDTO.CatalogDTO, error) {
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+
+	if _, ok := columns["kind"]; !ok {
+		return nil, errors.New("csv header has no kind column")
+	}
+
+	catalog := &catalogDTO.CatalogDTO{}
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+
+		row := &csvRow{columns: columns, record: record}
+
+		switch kind := row.str("kind"); kind {
+		case BANK:
+			catalog.Banks = append(catalog.Banks, &bankDTO.BankDTO{
+				ID:         row.str("id"),
+				Name:       row.str("name"),
+				Order:      row.int32("order"),
+				BankStatus: commonM.Status(row.int32("status")),
+			})
+
+		case CARD:
+			catalog.Cards = append(catalog.Cards, &cardDTO.CardDTO{
+				ID:           row.str("id"),
+				Name:         row.str("name"),
+				Descriptions: row.strs("descriptions"),
+				LinkURL:      row.str("linkURL"),
+				BankID:       row.str("bankID"),
+				Order:        row.int32("order"),
+				CardStatus:   commonM.Status(row.int32("status")),
+			})
+
+		case CHANNEL:
+			catalog.Channels = append(catalog.Channels, &channelDTO.ChannelDTO{
+				ID:            row.str("id"),
+				Name:          row.str("name"),
+				LinkURL:       row.str("linkURL"),
+				ChannelType:   row.int32("channelType"),
+				ChannelLabels: row.int32s("channelLabels"),
+				Order:         row.int32("order"),
+				ChannelStatus: commonM.Status(row.int32("status")),
+			})
+
+		case CHANNEL_LABEL:
+			catalog.ChannelLabels = append(catalog.ChannelLabels, &channelLabelDTO.ChannelLabelDTO{
+				Label: row.int32("label"),
+				Name:  row.str("name"),
+				Show:  row.int32("show"),
+			})
+
+		case REWARD:
+			reward := &rewardDTO.RewardDTO{
+				ID:           row.str("id"),
+				CardID:       row.str("cardID"),
+				Name:         row.str("name"),
+				StartDate:    row.int64("startDate"),
+				EndDate:      row.int64("endDate"),
+				Currency:     row.int32("currency"),
+				RewardType:   row.int32("rewardType"),
+				Order:        row.int32("order"),
+				RewardStatus: commonM.Status(row.int32("status")),
+			}
+
+			if description := row.str("description"); description != "" {
+				if !json.Valid([]byte(description)) {
+					row.err = errors.New("description is not valid json")
+				}
+				reward.Description = json.RawMessage(description)
+			}
+
+			if rule := row.str("rule"); rule != "" {
+				reward.Rule = &rewardDTO.RewardRuleDTO{}
+				if err := json.Unmarshal([]byte(rule), reward.Rule); err != nil {
+					row.err = err
+				}
+			}
+
+			catalog.Rewards = append(catalog.Rewards, reward)
+
+		default:
+			return nil, fmt.Errorf("line %d: unknown kind %q", line, kind)
+		}
+
+		if row.err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, row.err)
+		}
+	}
+
+	return catalog, nil
+}
+
+// csvRow reads the columns of a record by name and keeps the first error.
+type csvRow struct {
+	columns map[string]int
+	record  []string
+	err     error
+}
+
+func (r *csvRow) str(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r *csvRow) strs(column string) []string {
+	value := r.str(column)
+	if value == "" {
+		return nil
+	}
+
+	values := []string{}
+	for _, v := range strings.Split(value, "|") {
+		values = append(values, strings.TrimSpace(v))
+	}
+	return values
+}
+
+func (r *csvRow) int64(column string) int64 {
+	value := r.str(column)
+	if value == "" {
+		return 0
+	}
+
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("column %s: %s", column, err)
+	}
+	return i
+}
+
+func (r *csvRow) int32(column string) int32 {
+	value := r.str(column)
+	if value == "" {
+		return 0
+	}
+
+	i, err := strconv.ParseInt(value, 10, 32)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("column %s: %s", column, err)
+	}
+	return int32(i)
+}
+
+func (r *csvRow) int32s(column string) []int32 {
+	values := []int32{}
+	for _, v := range r.strs(column) {
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil && r.err == nil {
+			r.err = fmt.Errorf("column %s: %s", column, err)
+		}
+		values = append(values, int32(i))
+	}
+	return values
+}
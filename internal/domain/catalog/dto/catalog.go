@@ -0,0 +1,61 @@
+package dto
+
+import (
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	channelLabelDTO "pickrewardapi/internal/domain/channel_label/dto"
+)
+
+// CatalogDTO is the content of a catalog file, entities are matched to the
+// stored ones by their IDs, channel labels by their label.
+type CatalogDTO struct {
+	ChannelLabels []*channelLabelDTO.ChannelLabelDTO `json:"channelLabels"`
+	Banks         []*bankDTO.BankDTO                 `json:"banks"`
+	Cards         []*cardDTO.CardDTO                 `json:"cards"`
+	Channels      []*channelDTO.ChannelDTO           `json:"channels"`
+	Rewards       []*rewardDTO.RewardDTO             `json:"rewards"`
+}
+
+type ImportAction string
+
+const (
+	Create    ImportAction = "create"
+	Update    ImportAction = "update"
+	Unchanged ImportAction = "unchanged"
+)
+
+type ImportItemDTO struct {
+	Kind   string       `json:"kind"`
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Action ImportAction `json:"action"`
+
+	// Changes lists the fields an update changes.
+	Changes []*FieldChangeDTO `json:"changes,omitempty"`
+}
+
+// FieldChangeDTO is a changed field by its json name, with its stored and
+// imported values as JSON.
+type FieldChangeDTO struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type ImportResultDTO struct {
+	DryRun bool             `json:"dryRun"`
+	Items  []*ImportItemDTO `json:"items"`
+}
+
+// Count returns the number of items with the action.
+func (r *ImportResultDTO) Count(action ImportAction) int {
+	count := 0
+	for _, i := range r.Items {
+		if i.Action == action {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	bankStore "pickrewardapi/internal/domain/bank/store"
+	cardStore "pickrewardapi/internal/domain/card/store"
+	rewardStore "pickrewardapi/internal/domain/card_reward/store"
+	channelStore "pickrewardapi/internal/domain/channel/store"
+	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
+
+	catalogDomain "pickrewardapi/internal/domain/catalog/domain"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	channelDomain "pickrewardapi/internal/domain/channel/domain"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type CatalogService interface {
+	// Import upserts the catalog through the stores, nothing is written when
+	// any entity is invalid or dryRun is set.
+	Import(ctx context.Context, catalog *catalogDTO.CatalogDTO, dryRun bool) (*catalogDTO.ImportResultDTO, error)
+}
+
+var (
+	timeNow = time.Now
+)
+
+type impl struct {
+	dig.In
+
+	bankStore         bankStore.BankStore
+	cardStore         cardStore.CardStore
+	channelStore      channelStore.ChannelStore
+	channelLabelStore channelLabelStore.ChannelLabelStore
+	rewardStore       rewardStore.RewardStore
+}
+
+func New(
+	bankStore bankStore.BankStore,
+	cardStore cardStore.CardStore,
+	channelStore channelStore.ChannelStore,
+	channelLabelStore channelLabelStore.ChannelLabelStore,
+	rewardStore rewardStore.RewardStore,
+) CatalogService {
+
+	impl := &impl{
+		bankStore:         bankStore,
+		cardStore:         cardStore,
+		channelStore:      channelStore,
+		channelLabelStore: channelLabelStore,
+		rewardStore:       rewardStore,
+	}
+
+	return impl
+}
+
+func (im *impl) Import(ctx context.Context, catalog *catalogDTO.CatalogDTO, dryRun bool) (*catalogDTO.ImportResultDTO, error) {
+	logPos := "[catalog.service][Import]"
+
+	ctx, span := tracing.Start(ctx, "catalog.service.Import")
+	defer span.End()
+
+	if err := im.validate(ctx, catalog); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validate failed: ", err)
+		return nil, err
+	}
+
+	plan, err := im.plan(ctx, catalog)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("plan failed: ", err)
+		return nil, err
+	}
+
+	result := &catalogDTO.ImportResultDTO{
+		DryRun: dryRun,
+	}
+
+	for _, p := range plan {
+		result.Items = append(result.Items, p.item)
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, p := range plan {
+		if p.item.Action == catalogDTO.Unchanged {
+			continue
+		}
+
+		if err := p.write(ctx); err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos":  logPos,
+				"kind": p.item.Kind,
+				"ID":   p.item.ID,
+			}).Error("write failed: ", err)
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// validate checks every entity and that the banks, cards and channel labels
+// they refer to are in the catalog or stored, all problems are reported.
+func (im *impl) validate(ctx context.Context, catalog *catalogDTO.CatalogDTO) error {
+
+	errs := []error{}
+
+	labels := map[int32]bool{}
+	for _, l := range catalog.ChannelLabels {
+		if err := l.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("channel label %d: %w", l.Label, err))
+		}
+		labels[l.Label] = true
+	}
+
+	storedLabels, err := im.channelLabelStore.GetAllChannelLabels(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range storedLabels {
+		labels[l.Label] = true
+	}
+
+	bankIDs := map[string]bool{}
+	for _, b := range catalog.Banks {
+		if err := b.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("bank %s: %w", b.ID, err))
+		}
+		bankIDs[b.ID] = true
+	}
+
+	cardIDs := map[string]bool{}
+	for _, c := range catalog.Cards {
+		if err := c.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("card %s: %w", c.ID, err))
+		}
+		cardIDs[c.ID] = true
+
+		if c.BankID == "" || bankIDs[c.BankID] {
+			continue
+		}
+
+		if _, err := im.bankStore.GetBankByID(ctx, c.BankID); err != nil {
+			if !errors.Is(err, bankStore.ErrBankNotFound) {
+				return err
+			}
+			errs = append(errs, fmt.Errorf("card %s: Cannot find bankID %s", c.ID, c.BankID))
+			continue
+		}
+		bankIDs[c.BankID] = true
+	}
+
+	for _, c := range catalog.Channels {
+		if err := c.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("channel %s: %w", c.ID, err))
+		}
+
+		if channelDomain.GetChannelType(channelDomain.ChannelTypeEnum(c.ChannelType)) == nil {
+			errs = append(errs, fmt.Errorf("channel %s: Cannot find channel type %d", c.ID, c.ChannelType))
+		}
+
+		for _, l := range c.ChannelLabels {
+			if !labels[l] {
+				errs = append(errs, fmt.Errorf("channel %s: Cannot find channel label %d", c.ID, l))
+			}
+		}
+	}
+
+	for _, r := range catalog.Rewards {
+		if err := r.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("reward %s: %w", r.ID, err))
+		}
+
+		if r.CardID == "" || cardIDs[r.CardID] {
+			continue
+		}
+
+		card, err := im.cardStore.GetByCardID(ctx, r.CardID)
+		if err != nil {
+			return err
+		}
+		if card == nil {
+			errs = append(errs, fmt.Errorf("reward %s: Cannot find cardID %s", r.ID, r.CardID))
+			continue
+		}
+		cardIDs[r.CardID] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+type step struct {
+	item  *catalogDTO.ImportItemDTO
+	write func(ctx context.Context) error
+}
+
+// plan compares the catalog with the stored entities in the order they must
+// be written, labels and banks before the entities referring to them.
+func (im *impl) plan(ctx context.Context, catalog *catalogDTO.CatalogDTO) ([]*step, error) {
+
+	now := timeNow().Unix()
+	steps := []*step{}
+
+	for _, l := range catalog.ChannelLabels {
+		l := l
+
+		existing, err := im.channelLabelStore.GetChannelLabelByLabel(ctx, l.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		action, changes := catalogDTO.Create, []*catalogDTO.FieldChangeDTO(nil)
+		if existing != nil {
+			action, changes = compare(existing, l)
+		}
+
+		steps = append(steps, &step{
+			item: &catalogDTO.ImportItemDTO{Kind: catalogDomain.CHANNEL_LABEL, ID: fmt.Sprint(l.Label), Name: l.Name, Action: action, Changes: changes},
+			write: func(ctx context.Context) error {
+				return im.channelLabelStore.ModifiedChannelLabel(ctx, l)
+			},
+		})
+	}
+
+	for _, b := range catalog.Banks {
+		b := b
+
+		existing, err := im.bankStore.GetBankByID(ctx, b.ID)
+		if err != nil && !errors.Is(err, bankStore.ErrBankNotFound) {
+			return nil, err
+		}
+
+		action, changes := catalogDTO.Create, []*catalogDTO.FieldChangeDTO(nil)
+		b.CreateDate, b.UpdateDate = now, now
+		if existing != nil {
+			b.CreateDate, b.UpdateDate = existing.CreateDate, existing.UpdateDate
+			if action, changes = compare(existing, b); action == catalogDTO.Update {
+				b.UpdateDate = now
+			}
+		}
+
+		steps = append(steps, &step{
+			item: &catalogDTO.ImportItemDTO{Kind: catalogDomain.BANK, ID: b.ID, Name: b.Name, Action: action, Changes: changes},
+			write: func(ctx context.Context) error {
+				return im.bankStore.ModifiedBank(ctx, b)
+			},
+		})
+	}
+
+	for _, c := range catalog.Cards {
+		c := c
+
+		existing, err := im.cardStore.GetByCardID(ctx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		action, changes := catalogDTO.Create, []*catalogDTO.FieldChangeDTO(nil)
+		c.CreateDate, c.UpdateDate = now, now
+		if existing != nil {
+			c.CreateDate, c.UpdateDate = existing.CreateDate, existing.UpdateDate
+			if action, changes = compare(existing, c); action == catalogDTO.Update {
+				c.UpdateDate = now
+			}
+		}
+
+		steps = append(steps, &step{
+			item: &catalogDTO.ImportItemDTO{Kind: catalogDomain.CARD, ID: c.ID, Name: c.Name, Action: action, Changes: changes},
+			write: func(ctx context.Context) error {
+				return im.cardStore.ModifiedCard(ctx, c)
+			},
+		})
+	}
+
+	for _, c := range catalog.Channels {
+		c := c
+
+		existing, err := im.channelStore.GetChannelByID(ctx, c.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		action, changes := catalogDTO.Create, []*catalogDTO.FieldChangeDTO(nil)
+		c.CreateDate, c.UpdateDate = now, now
+		if existing != nil {
+			c.CreateDate, c.UpdateDate = existing.CreateDate, existing.UpdateDate
+			if action, changes = compare(existing, c); action == catalogDTO.Update {
+				c.UpdateDate = now
+			}
+		}
+
+		steps = append(steps, &step{
+			item: &catalogDTO.ImportItemDTO{Kind: catalogDomain.CHANNEL, ID: c.ID, Name: c.Name, Action: action, Changes: changes},
+			write: func(ctx context.Context) error {
+				return im.channelStore.ModifiedChannel(ctx, c)
+			},
+		})
+	}
+
+	for _, r := range catalog.Rewards {
+		r := r
+
+		existing, err := im.rewardStore.GetRewardByID(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		action, changes := catalogDTO.Create, []*catalogDTO.FieldChangeDTO(nil)
+		r.CreateDate, r.UpdateDate = now, now
+		if existing != nil {
+			r.CreateDate, r.UpdateDate = existing.CreateDate, existing.UpdateDate
+			if action, changes = compare(existing, r); action == catalogDTO.Update {
+				r.UpdateDate = now
+			}
+		}
+
+		steps = append(steps, &step{
+			item: &catalogDTO.ImportItemDTO{Kind: catalogDomain.REWARD, ID: r.ID, Name: r.Name, Action: action, Changes: changes},
+			write: func(ctx context.Context) error {
+				return im.rewardStore.ModifiedReward(ctx, r)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+// compare tells whether the entity differs from the stored one by their
+// JSON forms and lists the changed fields, the dates must already be copied
+// from the stored one.
+func compare(existing, entity interface{}) (catalogDTO.ImportAction, []*catalogDTO.FieldChangeDTO) {
+	a, errA := fields(existing)
+	b, errB := fields(entity)
+	if errA != nil || errB != nil {
+		return catalogDTO.Update, nil
+	}
+
+	names := []string{}
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*catalogDTO.FieldChangeDTO{}
+	for _, name := range names {
+		if string(a[name]) == string(b[name]) {
+			continue
+		}
+
+		changes = append(changes, &catalogDTO.FieldChangeDTO{
+			Field: name,
+			Old:   string(a[name]),
+			New:   string(b[name]),
+		})
+	}
+
+	if len(changes) == 0 {
+		return catalogDTO.Unchanged, nil
+	}
+	return catalogDTO.Update, changes
+}
+
+// fields returns the JSON form of each field of the entity.
+func fields(entity interface{}) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
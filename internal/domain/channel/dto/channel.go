@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"errors"
+	"fmt"
+
 	commonM "pickrewardapi/internal/shared/common/model"
 )
 
@@ -23,3 +26,22 @@ type ChannelDTO struct {
 	Order         int32          `json:"order"`
 	ChannelStatus commonM.Status `json:"channelStatus"`
 }
+
+// Validate checks the channel can be stored, the channel type and labels are
+// checked by the service.
+func (c *ChannelDTO) Validate() error {
+
+	if c.ID == "" {
+		return errors.New("channel id is empty")
+	}
+
+	if c.Name == "" {
+		return errors.New("channel name is empty")
+	}
+
+	if _, err := commonM.GetStatus(int32(c.ChannelStatus)); err != nil {
+		return fmt.Errorf("invalid channel status: %d", c.ChannelStatus)
+	}
+
+	return nil
+}
@@ -2,33 +2,48 @@ package service
 
 import (
 	"context"
-	"errors"
 	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 
+	baseCtx "pickrewardapi/base/ctx"
+
 	channelDTO "pickrewardapi/internal/domain/channel/dto"
 
 	channelDomain "pickrewardapi/internal/domain/channel/domain"
 	channelStore "pickrewardapi/internal/domain/channel/store"
+	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
+	"pickrewardapi/internal/shared/common/errs"
 	commonM "pickrewardapi/internal/shared/common/model"
+	"pickrewardapi/internal/shared/common/page"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type ChannelService interface {
 	GetChannelTypes(ctx context.Context) []*channelDTO.ChannelTypeDTO
-	GetChannelsByType(ctx context.Context, ctype int32, limit, offset int32) ([]*channelDTO.ChannelDTO, error)
+	GetChannelsByType(ctx context.Context, ctype int32, req page.Request) ([]*channelDTO.ChannelDTO, *page.Info, error)
+	// GetsByChannelIDs is not paged, it takes at most page.MAX_SIZE IDs.
 	GetsByChannelIDs(ctx context.Context, IDs []string) ([]*channelDTO.ChannelDTO, error)
-	SearchChannel(ctx context.Context, keyword string) ([]*channelDTO.ChannelDTO, error)
+	SearchChannel(ctx context.Context, keyword string, req page.Request) ([]*channelDTO.ChannelDTO, *page.Info, error)
 
 	GetChannelTypeByType(ctx context.Context, ctype int32) (*channelDTO.ChannelTypeDTO, error)
+	GetChannelLabelNames(ctx context.Context) (map[int32]string, error)
+
+	CreateChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) (*channelDTO.ChannelDTO, error)
+	UpdateChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) (*channelDTO.ChannelDTO, error)
+	UpdateChannelStatus(ctx context.Context, ID string, status commonM.Status) (*channelDTO.ChannelDTO, error)
 }
 
 type impl struct {
 	dig.In
 
-	channelStore channelStore.ChannelStore
+	channelStore      channelStore.ChannelStore
+	channelLabelStore channelLabelStore.ChannelLabelStore
 }
 
 var (
@@ -37,10 +52,12 @@ var (
 
 func New(
 	channelStore channelStore.ChannelStore,
+	channelLabelStore channelLabelStore.ChannelLabelStore,
 ) ChannelService {
 
 	impl := &impl{
-		channelStore: channelStore,
+		channelStore:      channelStore,
+		channelLabelStore: channelLabelStore,
 	}
 
 	return impl
@@ -49,12 +66,15 @@ func New(
 func (im *impl) GetChannelTypeByType(ctx context.Context, ctype int32) (*channelDTO.ChannelTypeDTO, error) {
 	logPos := "[channel.service][GetChannelTypeByType]"
 
+	ctx, span := tracing.Start(ctx, "channel.service.GetChannelTypeByType")
+	defer span.End()
+
 	channelType := channelDomain.GetChannelType(channelDomain.ChannelTypeEnum(ctype))
 	if channelType == nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("GetChannelType is nil")
-		return nil, errors.New("GetChannelType is nil")
+		return nil, errs.NewNotFound("GetChannelType is nil")
 	}
 
 	return &channelDTO.ChannelTypeDTO{
@@ -66,6 +86,9 @@ func (im *impl) GetChannelTypeByType(ctx context.Context, ctype int32) (*channel
 
 func (im *impl) GetChannelTypes(ctx context.Context) []*channelDTO.ChannelTypeDTO {
 
+	ctx, span := tracing.Start(ctx, "channel.service.GetChannelTypes")
+	defer span.End()
+
 	channelCategoryDTOs := []*channelDTO.ChannelTypeDTO{}
 
 	for _, c := range channelDomain.GetChannelTypes() {
@@ -82,41 +105,88 @@ func (im *impl) GetChannelTypes(ctx context.Context) []*channelDTO.ChannelTypeDT
 	return channelCategoryDTOs
 }
 
-func (im *impl) GetChannelsByType(ctx context.Context, channelType int32, limit, offset int32) ([]*channelDTO.ChannelDTO, error) {
+func (im *impl) GetChannelsByType(ctx context.Context, channelType int32, req page.Request) ([]*channelDTO.ChannelDTO, *page.Info, error) {
 	logPos := "[channel.service][GetChannelsByType]"
 
-	channelDTOs, err := im.channelStore.GetChannelsByType(ctx, channelType, commonM.Active, limit, offset)
+	ctx, span := tracing.Start(ctx, "channel.service.GetChannelsByType")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	cursor, err := page.Open(req, "channels.type", channelType, statuses)
 	if err != nil {
-		log.WithFields(log.Fields{
+		return nil, nil, err
+	}
+
+	channelDTOs, total, err := im.channelStore.GetChannelsByType(ctx, channelType, statuses, cursor.Offset, cursor.Size)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos":          logPos,
 			"channel.type": channelType,
 		}).Error("channelStore.GetChannelsByType: ", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	sort.SliceStable(channelDTOs, func(i, j int) bool {
-		return channelDTOs[i].Order < channelDTOs[j].Order
-	})
-
-	return channelDTOs, nil
+	return channelDTOs, cursor.Info(total), nil
 }
 
 func (im *impl) GetByChannelID(ctx context.Context, ID string) (*channelDTO.ChannelDTO, error) {
-	return im.channelStore.GetChannelByID(ctx, ID)
+	logPos := "[channel.service][GetByChannelID]"
+
+	ctx, span := tracing.Start(ctx, "channel.service.GetByChannelID")
+	defer span.End()
+
+	channel, err := im.channelStore.GetChannelByID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("channelStore.GetChannelByID failed: ", err)
+		return nil, err
+	}
+
+	if channel == nil || !preview.Visible(ctx, channel.ChannelStatus) {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("Cannot find channelID")
+		return nil, errs.NewNotFound("Cannot find channelID")
+	}
+
+	return channel, nil
 }
 
 func (im *impl) GetsByChannelIDs(ctx context.Context, IDs []string) ([]*channelDTO.ChannelDTO, error) {
 	logPos := "[channel.service][GetsByChannelIDs]"
 
+	ctx, span := tracing.Start(ctx, "channel.service.GetsByChannelIDs")
+	defer span.End()
+
+	if len(IDs) > int(page.MAX_SIZE) {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+			"len": len(IDs),
+		}).Error("Too many channel IDs")
+		return nil, errs.NewInvalidArgument("too many channel IDs: %d, at most %d", len(IDs), page.MAX_SIZE)
+	}
+
 	channelDTOs, err := im.channelStore.GetChannelByIDs(ctx, IDs)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 			"IDs": IDs,
 		}).Error("GetChannelByIDs failed: ", err)
 		return nil, err
 	}
 
+	visibleChannelDTOs := []*channelDTO.ChannelDTO{}
+	for _, c := range channelDTOs {
+		if preview.Visible(ctx, c.ChannelStatus) {
+			visibleChannelDTOs = append(visibleChannelDTOs, c)
+		}
+	}
+	channelDTOs = visibleChannelDTOs
+
 	sort.SliceStable(channelDTOs, func(i, j int) bool {
 		return channelDTOs[i].Order < channelDTOs[j].Order
 	})
@@ -124,22 +194,193 @@ func (im *impl) GetsByChannelIDs(ctx context.Context, IDs []string) ([]*channelD
 	return channelDTOs, nil
 }
 
-func (im *impl) SearchChannel(ctx context.Context, keyword string) ([]*channelDTO.ChannelDTO, error) {
+func (im *impl) SearchChannel(ctx context.Context, keyword string, req page.Request) ([]*channelDTO.ChannelDTO, *page.Info, error) {
 	logPos := "[channel.service][SearchChannel]"
 
-	channelDTOs, err := im.channelStore.SearchChannel(ctx, keyword, commonM.Active)
+	ctx, span := tracing.Start(ctx, "channel.service.SearchChannel")
+	defer span.End()
+
+	statuses := preview.Statuses(ctx)
+
+	cursor, err := page.Open(req, "channels.search", keyword, statuses)
 	if err != nil {
-		log.WithFields(log.Fields{
+		return nil, nil, err
+	}
+
+	channelDTOs, total, err := im.channelStore.SearchChannel(ctx, keyword, statuses, cursor.Offset, cursor.Size)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos":     logPos,
 			"keyword": keyword,
 		}).Error("channelStore.SearchChannel: ", err)
+		return nil, nil, err
+	}
+
+	return channelDTOs, cursor.Info(total), nil
+
+}
+
+func (im *impl) GetChannelLabelNames(ctx context.Context) (map[int32]string, error) {
+	logPos := "[channel.service][GetChannelLabelNames]"
+
+	ctx, span := tracing.Start(ctx, "channel.service.GetChannelLabelNames")
+	defer span.End()
+
+	channelLabelDTOs, err := im.channelLabelStore.GetAllChannelLabels(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("channelLabelStore.GetAllChannelLabels failed: ", err)
 		return nil, err
 	}
 
-	sort.SliceStable(channelDTOs, func(i, j int) bool {
-		return channelDTOs[i].ChannelType < channelDTOs[j].ChannelType
-	})
+	labelNames := make(map[int32]string, len(channelLabelDTOs))
+	for _, c := range channelLabelDTOs {
+		labelNames[c.Label] = c.Name
+	}
 
-	return channelDTOs, nil
+	return labelNames, nil
+}
+
+func (im *impl) CreateChannel(ctx context.Context, channel *channelDTO.ChannelDTO) (*channelDTO.ChannelDTO, error) {
+	logPos := "[channel.service][CreateChannel]"
+
+	ctx, span := tracing.Start(ctx, "channel.service.CreateChannel")
+	defer span.End()
+
+	now := timeNow().Unix()
+	channel.ID = commonS.GenUUID()
+	channel.CreateDate = now
+	channel.UpdateDate = now
+
+	if err := im.validateChannel(ctx, channel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validateChannel failed: ", err)
+		return nil, err
+	}
+
+	if err := im.channelStore.ModifiedChannel(ctx, channel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": channel.ID,
+		}).Error("channelStore.ModifiedChannel failed: ", err)
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func (im *impl) UpdateChannel(ctx context.Context, channel *channelDTO.ChannelDTO) (*channelDTO.ChannelDTO, error) {
+	logPos := "[channel.service][UpdateChannel]"
+
+	ctx, span := tracing.Start(ctx, "channel.service.UpdateChannel")
+	defer span.End()
+
+	existing, err := im.channelStore.GetChannelByID(ctx, channel.ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": channel.ID,
+		}).Error("channelStore.GetChannelByID failed: ", err)
+		return nil, err
+	}
+
+	if existing == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": channel.ID,
+		}).Error("Cannot find channelID")
+		return nil, errs.NewNotFound("Cannot find channelID")
+	}
+
+	channel.CreateDate = existing.CreateDate
+	channel.UpdateDate = timeNow().Unix()
+
+	if err := im.validateChannel(ctx, channel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": channel.ID,
+		}).Error("validateChannel failed: ", err)
+		return nil, err
+	}
+
+	if err := im.channelStore.ModifiedChannel(ctx, channel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": channel.ID,
+		}).Error("channelStore.ModifiedChannel failed: ", err)
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func (im *impl) UpdateChannelStatus(ctx context.Context, ID string, status commonM.Status) (*channelDTO.ChannelDTO, error) {
+	logPos := "[channel.service][UpdateChannelStatus]"
+
+	ctx, span := tracing.Start(ctx, "channel.service.UpdateChannelStatus")
+	defer span.End()
+
+	channel, err := im.channelStore.GetChannelByID(ctx, ID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("channelStore.GetChannelByID failed: ", err)
+		return nil, err
+	}
+
+	if channel == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("Cannot find channelID")
+		return nil, errs.NewNotFound("Cannot find channelID")
+	}
+
+	channel.ChannelStatus = status
+	channel.UpdateDate = timeNow().Unix()
+
+	if err := channel.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("channel.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.channelStore.ModifiedChannel(ctx, channel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":        logPos,
+			"channel.ID": ID,
+		}).Error("channelStore.ModifiedChannel failed: ", err)
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func (im *impl) validateChannel(ctx context.Context, channel *channelDTO.ChannelDTO) error {
+
+	if err := channel.Validate(); err != nil {
+		return errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if channelDomain.GetChannelType(channelDomain.ChannelTypeEnum(channel.ChannelType)) == nil {
+		return errs.NewInvalidArgument("Cannot find channel type: %d", channel.ChannelType)
+	}
+
+	labelNames, err := im.GetChannelLabelNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range channel.ChannelLabels {
+		if _, ok := labelNames[l]; !ok {
+			return errs.NewInvalidArgument("Cannot find channel label: %d", l)
+		}
+	}
 
+	return nil
 }
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	"pickrewardapi/internal/pkg/cache"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// CACHE_NAMESPACE is the cache namespace of the channel queries.
+const CACHE_NAMESPACE = CHANNEL
+
+// cached reads GetChannelsByType through the cache and invalidates the
+// namespace when a channel is modified.
+type cached struct {
+	ChannelStore
+
+	cache *cache.Cache
+}
+
+func (cs *cached) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) error {
+	if err := cs.ChannelStore.ModifiedChannel(ctx, channelDTO); err != nil {
+		return err
+	}
+
+	cs.cache.Invalidate(ctx, CACHE_NAMESPACE)
+	return nil
+}
+
+// channelsPage is a page of channels with the count of all the channels, as
+// it is kept in the cache.
+type channelsPage struct {
+	Channels []*channelDTO.ChannelDTO `json:"channels"`
+	Total    int32                    `json:"total"`
+}
+
+func (cs *cached) GetChannelsByType(ctx context.Context, ctype int32, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error) {
+	p, err := cache.Fetch(ctx, cs.cache, cache.Key(CACHE_NAMESPACE, "type", ctype, statuses, offset, limit),
+		func(ctx context.Context) (*channelsPage, error) {
+			channels, total, err := cs.ChannelStore.GetChannelsByType(ctx, ctype, statuses, offset, limit)
+			if err != nil {
+				return nil, err
+			}
+			return &channelsPage{Channels: channels, Total: total}, nil
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return p.Channels, p.Total, nil
+}
@@ -11,16 +11,20 @@ import (
 	"go.uber.org/dig"
 
 	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	"pickrewardapi/internal/pkg/cache"
 	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
 	commonM "pickrewardapi/internal/shared/common/model"
 )
 
 type ChannelStore interface {
 	ModifiedChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) error
-	GetChannelsByType(ctx context.Context, channelCategoryType int32, status commonM.Status, limit, offset int32) ([]*channelDTO.ChannelDTO, error)
+	// GetChannelsByType and SearchChannel return at most limit channels from
+	// offset and the count of all the matching channels.
+	GetChannelsByType(ctx context.Context, channelCategoryType int32, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error)
 	GetChannelByID(ctx context.Context, ID string) (*channelDTO.ChannelDTO, error)
 	GetChannelByIDs(ctx context.Context, IDs []string) ([]*channelDTO.ChannelDTO, error)
-	SearchChannel(ctx context.Context, keyword string, status commonM.Status) ([]*channelDTO.ChannelDTO, error)
+	SearchChannel(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error)
 }
 
 type impl struct {
@@ -30,16 +34,19 @@ type impl struct {
 	migration *pgx.ConnPool
 }
 
-func New(sql *psql.Psql) ChannelStore {
+func New(sql *psql.Psql, c *cache.Cache) ChannelStore {
 	logPos := "[channel.store][New]"
 
 	log.WithFields(log.Fields{
 		"pos": logPos,
 	}).Info("init channel store")
 
-	return &impl{
-		primary:   sql.Primary,
-		migration: sql.Migration,
+	return &cached{
+		ChannelStore: &impl{
+			primary:   sql.Primary,
+			migration: sql.Migration,
+		},
+		cache: c,
 	}
 }
 
@@ -59,7 +66,10 @@ var MODIFIED_CHANNEL_STAT = fmt.Sprintf("INSERT INTO %s "+
 func (im *impl) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) error {
 	logPos := "[channel.store][ModifiedChannel]"
 
-	tx, err := im.primary.Begin()
+	ctx, span := tracing.StartQuery(ctx, "channel.store.ModifiedChannel", "MODIFIED_CHANNEL_STAT")
+	defer span.End()
+
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":        logPos,
@@ -83,6 +93,8 @@ func (im *impl) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.Chan
 		channelDTO.Name,
 		channelDTO.LinkURL,
 		channelDTO.ChannelType,
+		channelDTO.CreateDate,
+		channelDTO.UpdateDate,
 		channelDTO.ChannelLabels,
 		channelDTO.Order,
 		channelDTO.ChannelStatus,
@@ -90,12 +102,14 @@ func (im *impl) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.Chan
 		channelDTO.Name,
 		channelDTO.LinkURL,
 		channelDTO.ChannelType,
+		channelDTO.CreateDate,
+		channelDTO.UpdateDate,
 		channelDTO.ChannelLabels,
 		channelDTO.Order,
 		channelDTO.ChannelStatus,
 	}
 
-	if _, err := tx.Exec(MODIFIED_CHANNEL_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, MODIFIED_CHANNEL_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
 			"pos":        logPos,
 			"chennel.ID": channelDTO.ID,
@@ -103,7 +117,7 @@ func (im *impl) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.Chan
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -113,25 +127,34 @@ func (im *impl) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.Chan
 	return nil
 }
 
-var SELECT_CHANNELS_BY_CHANNEL_TYPE_STAT = fmt.Sprintf("SELECT %s "+
-	" FROM %s "+
-	" WHERE \"channel_type\" = $1 "+
-	" AND channel_status = $2 "+
-	" ORDER BY \"order\" "+
-	" LIMIT $3 OFFSET $4 ", ALL_COLUMNS, CHANNEL)
+const CHANNELS_BY_CHANNEL_TYPE_WHERE = " WHERE \"channel_type\" = $1 " +
+	" AND channel_status = ANY($2) "
+
+var SELECT_CHANNELS_BY_CHANNEL_TYPE_STAT = fmt.Sprintf("SELECT %s, COUNT(*) OVER() "+
+	" FROM %s %s "+
+	" ORDER BY \"order\", \"id\" "+
+	" LIMIT $3 OFFSET $4 ", ALL_COLUMNS, CHANNEL, CHANNELS_BY_CHANNEL_TYPE_WHERE)
+
+var COUNT_CHANNELS_BY_CHANNEL_TYPE_STAT = fmt.Sprintf("SELECT COUNT(*) "+
+	" FROM %s %s", CHANNEL, CHANNELS_BY_CHANNEL_TYPE_WHERE)
 
-func (im *impl) GetChannelsByType(ctx context.Context, ctype int32, status commonM.Status, limit, offset int32) ([]*channelDTO.ChannelDTO, error) {
+func (im *impl) GetChannelsByType(ctx context.Context, ctype int32, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error) {
 	logPos := "[channel.store][GetChannelsByType]"
 
+	ctx, span := tracing.StartQuery(ctx, "channel.store.GetChannelsByType", "SELECT_CHANNELS_BY_CHANNEL_TYPE_STAT")
+	defer span.End()
+
 	channelDTOs := []*channelDTO.ChannelDTO{}
 
-	rows, err := im.primary.Query(SELECT_CHANNELS_BY_CHANNEL_TYPE_STAT, ctype, status, limit, offset)
+	var total int32
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNELS_BY_CHANNEL_TYPE_STAT, nil, ctype, commonM.Int32s(statuses), limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":          logPos,
 			"channel.type": ctype,
 		}).Error("psql.Query failed: ", err)
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -147,6 +170,7 @@ func (im *impl) GetChannelsByType(ctx context.Context, ctype int32, status commo
 			&channelDTO.ChannelLabels,
 			&channelDTO.Order,
 			&channelDTO.ChannelStatus,
+			&total,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
@@ -154,12 +178,24 @@ func (im *impl) GetChannelsByType(ctx context.Context, ctype int32, status commo
 				"pos":          logPos,
 				"channel.type": ctype,
 			}).Error("rows.Scan failed: ", err)
-			return nil, err
+			return nil, 0, err
 		}
 		channelDTOs = append(channelDTOs, channelDTO)
 	}
 
-	return channelDTOs, nil
+	// a page past the last channel has no row carrying the count
+	if len(channelDTOs) == 0 && offset > 0 {
+		total, err = im.count(ctx, "COUNT_CHANNELS_BY_CHANNEL_TYPE_STAT", COUNT_CHANNELS_BY_CHANNEL_TYPE_STAT, ctype, commonM.Int32s(statuses))
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos":          logPos,
+				"channel.type": ctype,
+			}).Error("count failed: ", err)
+			return nil, 0, err
+		}
+	}
+
+	return channelDTOs, total, nil
 }
 
 var SELECT_CHANNEL_BY_ID_STAT = fmt.Sprintf("SELECT %s "+
@@ -169,9 +205,12 @@ func (im *impl) GetChannelByID(ctx context.Context, ID string) (*channelDTO.Chan
 
 	logPos := "[channel.store][GetByChannelID]"
 
-	channelDTO := &channelDTO.ChannelDTO{}
+	ctx, span := tracing.StartQuery(ctx, "channel.store.GetChannelByID", "SELECT_CHANNEL_BY_ID_STAT")
+	defer span.End()
 
-	rows, err := im.primary.Query(SELECT_CHANNEL_BY_ID_STAT, ID)
+	var c *channelDTO.ChannelDTO
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNEL_BY_ID_STAT, nil, ID)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":        logPos,
@@ -182,16 +221,18 @@ func (im *impl) GetChannelByID(ctx context.Context, ID string) (*channelDTO.Chan
 	defer rows.Close()
 
 	for rows.Next() {
+
+		c = &channelDTO.ChannelDTO{}
 		selector := []interface{}{
-			&channelDTO.ID,
-			&channelDTO.Name,
-			&channelDTO.LinkURL,
-			&channelDTO.ChannelType,
-			&channelDTO.CreateDate,
-			&channelDTO.UpdateDate,
-			&channelDTO.ChannelLabels,
-			&channelDTO.Order,
-			&channelDTO.ChannelStatus,
+			&c.ID,
+			&c.Name,
+			&c.LinkURL,
+			&c.ChannelType,
+			&c.CreateDate,
+			&c.UpdateDate,
+			&c.ChannelLabels,
+			&c.Order,
+			&c.ChannelStatus,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
@@ -204,7 +245,7 @@ func (im *impl) GetChannelByID(ctx context.Context, ID string) (*channelDTO.Chan
 
 	}
 
-	return channelDTO, nil
+	return c, nil
 }
 
 var SELECT_CHANNELS_BY_IDs_STAT = fmt.Sprintf("SELECT %s "+
@@ -214,9 +255,12 @@ func (im *impl) GetChannelByIDs(ctx context.Context, IDs []string) ([]*channelDT
 
 	logPos := "[channel.store][GetChannelByIDs]"
 
+	ctx, span := tracing.StartQuery(ctx, "channel.store.GetChannelByIDs", "SELECT_CHANNELS_BY_IDs_STAT")
+	defer span.End()
+
 	channelDTOs := []*channelDTO.ChannelDTO{}
 
-	rows, err := im.primary.Query(SELECT_CHANNELS_BY_IDs_STAT, pq.Array(IDs))
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNELS_BY_IDs_STAT, nil, pq.Array(IDs))
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -251,15 +295,23 @@ func (im *impl) GetChannelByIDs(ctx context.Context, IDs []string) ([]*channelDT
 	return channelDTOs, nil
 }
 
-var SELECT_CHANNELS_BY_KEYWORD_STAT = fmt.Sprintf("SELECT %s "+
-	" FROM %s "+
-	" WHERE channel_status = $1 "+
-	" AND name ~~* $2 "+
-	" LIMIT 20 ", ALL_COLUMNS, CHANNEL)
+const CHANNELS_BY_KEYWORD_WHERE = " WHERE channel_status = ANY($1) " +
+	" AND name ~~* $2 "
 
-func (im *impl) SearchChannel(ctx context.Context, keyword string, status commonM.Status) ([]*channelDTO.ChannelDTO, error) {
+var SELECT_CHANNELS_BY_KEYWORD_STAT = fmt.Sprintf("SELECT %s, COUNT(*) OVER() "+
+	" FROM %s %s "+
+	" ORDER BY \"channel_type\", \"order\", \"id\" "+
+	" LIMIT $3 OFFSET $4 ", ALL_COLUMNS, CHANNEL, CHANNELS_BY_KEYWORD_WHERE)
+
+var COUNT_CHANNELS_BY_KEYWORD_STAT = fmt.Sprintf("SELECT COUNT(*) "+
+	" FROM %s %s", CHANNEL, CHANNELS_BY_KEYWORD_WHERE)
+
+func (im *impl) SearchChannel(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error) {
 	logPos := "[channel.store][SearchChannel]"
 
+	ctx, span := tracing.StartQuery(ctx, "channel.store.SearchChannel", "SELECT_CHANNELS_BY_KEYWORD_STAT")
+	defer span.End()
+
 	channelDTOs := []*channelDTO.ChannelDTO{}
 
 	var builder strings.Builder
@@ -268,12 +320,14 @@ func (im *impl) SearchChannel(ctx context.Context, keyword string, status common
 	builder.WriteString("%")
 	concatKeyword := builder.String()
 
-	rows, err := im.primary.Query(SELECT_CHANNELS_BY_KEYWORD_STAT, status, concatKeyword)
+	var total int32
+
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNELS_BY_KEYWORD_STAT, nil, commonM.Int32s(statuses), concatKeyword, limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("psql.Query failed: ", err)
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer rows.Close()
@@ -290,15 +344,42 @@ func (im *impl) SearchChannel(ctx context.Context, keyword string, status common
 			&channelDTO.ChannelLabels,
 			&channelDTO.Order,
 			&channelDTO.ChannelStatus,
+			&total,
 		}
 
 		if err := rows.Scan(selector...); err != nil {
 			log.WithFields(log.Fields{
 				"pos": logPos,
 			}).Error("rows.Scan failed: ", err)
-			return nil, err
+			return nil, 0, err
 		}
 		channelDTOs = append(channelDTOs, channelDTO)
 	}
-	return channelDTOs, nil
+
+	// a page past the last channel has no row carrying the count
+	if len(channelDTOs) == 0 && offset > 0 {
+		total, err = im.count(ctx, "COUNT_CHANNELS_BY_KEYWORD_STAT", COUNT_CHANNELS_BY_KEYWORD_STAT, commonM.Int32s(statuses), concatKeyword)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("count failed: ", err)
+			return nil, 0, err
+		}
+	}
+
+	return channelDTOs, total, nil
+}
+
+// count runs the COUNT(*) stat named statName.
+func (im *impl) count(ctx context.Context, statName, stat string, args ...interface{}) (int32, error) {
+
+	ctx, span := tracing.StartQuery(ctx, "channel.store.count", statName)
+	defer span.End()
+
+	var total int32
+	if err := im.primary.QueryRowEx(ctx, stat, nil, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
 }
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	"pickrewardapi/internal/pkg/memdb"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// memory is a ChannelStore kept in memory, used by the demo mode instead of
+// Postgres.
+type memory struct {
+	channels *memdb.Table[string, channelDTO.ChannelDTO]
+}
+
+func NewMemory() ChannelStore {
+	logPos := "[channel.store][NewMemory]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Info("init memory channel store")
+
+	return &memory{
+		channels: memdb.NewTable[string, channelDTO.ChannelDTO](),
+	}
+}
+
+func (m *memory) ModifiedChannel(ctx context.Context, channelDTO *channelDTO.ChannelDTO) error {
+	return m.channels.Put(channelDTO.ID, channelDTO)
+}
+
+func (m *memory) GetChannelsByType(ctx context.Context, ctype int32, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error) {
+	channels, err := m.channels.Select(func(c *channelDTO.ChannelDTO) bool {
+		return c.ChannelType == ctype && slices.Contains(statuses, c.ChannelStatus)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortByOrder(channels)
+	channels, total := memdb.Limit(channels, offset, limit)
+	return channels, total, nil
+}
+
+func (m *memory) GetChannelByID(ctx context.Context, ID string) (*channelDTO.ChannelDTO, error) {
+	return m.channels.Get(ID)
+}
+
+func (m *memory) GetChannelByIDs(ctx context.Context, IDs []string) ([]*channelDTO.ChannelDTO, error) {
+	ids := map[string]bool{}
+	for _, ID := range IDs {
+		ids[ID] = true
+	}
+
+	channels, err := m.channels.Select(func(c *channelDTO.ChannelDTO) bool {
+		return ids[c.ID]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByOrder(channels)
+	return channels, nil
+}
+
+// SearchChannel matches the keyword case-insensitively in the name, as ~~*
+// of SearchChannel in Postgres, and orders the channels by type first.
+func (m *memory) SearchChannel(ctx context.Context, keyword string, statuses []commonM.Status, offset, limit int32) ([]*channelDTO.ChannelDTO, int32, error) {
+	keyword = strings.ToLower(keyword)
+
+	channels, err := m.channels.Select(func(c *channelDTO.ChannelDTO) bool {
+		return slices.Contains(statuses, c.ChannelStatus) && strings.Contains(strings.ToLower(c.Name), keyword)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortByOrder(channels)
+	sort.SliceStable(channels, func(i, j int) bool {
+		return channels[i].ChannelType < channels[j].ChannelType
+	})
+	channels, total := memdb.Limit(channels, offset, limit)
+	return channels, total, nil
+}
+
+func sortByOrder(channels []*channelDTO.ChannelDTO) {
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].Order != channels[j].Order {
+			return channels[i].Order < channels[j].Order
+		}
+		return channels[i].ID < channels[j].ID
+	})
+}
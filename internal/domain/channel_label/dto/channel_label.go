@@ -1,8 +1,31 @@
 package dto
 
+import (
+	"errors"
+	"fmt"
+)
 
 type ChannelLabelDTO struct {
 	Label int32  `json:"label"`
 	Name  string `json:"name"`
 	Show  int32  `json:"show"`
 }
+
+// Validate checks the channel label can be stored, show is 1 for a label
+// listed to users and 0 for a hidden one.
+func (c *ChannelLabelDTO) Validate() error {
+
+	if c.Label < 0 {
+		return fmt.Errorf("invalid channel label: %d", c.Label)
+	}
+
+	if c.Name == "" {
+		return errors.New("channel label name is empty")
+	}
+
+	if c.Show != 0 && c.Show != 1 {
+		return fmt.Errorf("invalid channel label show: %d", c.Show)
+	}
+
+	return nil
+}
@@ -2,17 +2,26 @@ package service
 
 import (
 	"context"
-	"errors"
+
+	baseCtx "pickrewardapi/base/ctx"
 
 	channelDTO "pickrewardapi/internal/domain/channel_label/dto"
 	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
+	"pickrewardapi/internal/shared/common/errs"
+	"pickrewardapi/internal/shared/common/page"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
+
+	"pickrewardapi/internal/pkg/tracing"
 )
 
 type ChannelLabelAppService interface {
-	GetShowChannelLabels(ctx context.Context) ([]*channelDTO.ChannelLabelDTO, error)
+	GetShowChannelLabels(ctx context.Context, req page.Request) ([]*channelDTO.ChannelLabelDTO, *page.Info, error)
+	GetChannelLabelByLabel(ctx context.Context, label int32) (*channelDTO.ChannelLabelDTO, error)
+
+	ModifyChannelLabel(ctx context.Context, channelLabelDTO *channelDTO.ChannelLabelDTO) (*channelDTO.ChannelLabelDTO, error)
+	UpdateChannelLabelShow(ctx context.Context, label int32, show int32) (*channelDTO.ChannelLabelDTO, error)
 }
 
 type impl struct {
@@ -32,15 +41,18 @@ func New(
 	return impl
 }
 
-func (im *impl) GetShowChannelLabels(ctx context.Context) ([]*channelDTO.ChannelLabelDTO, error) {
+func (im *impl) GetShowChannelLabels(ctx context.Context, req page.Request) ([]*channelDTO.ChannelLabelDTO, *page.Info, error) {
 	logPos := "[channel_label.app.service][GetShowChannelLabels]"
 
+	ctx, span := tracing.Start(ctx, "channel_label.service.GetShowChannelLabels")
+	defer span.End()
+
 	channelLabels, err := im.channelLabelStore.GetAllChannelLabels(ctx)
 	if err != nil {
-		log.WithFields(log.Fields{
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("channelLabelStore.GetAllChannelLabels ", err)
-		return nil, errors.New("GetChannelCategoryType is nil")
+		return nil, nil, err
 
 	}
 
@@ -51,5 +63,76 @@ func (im *impl) GetShowChannelLabels(ctx context.Context) ([]*channelDTO.Channel
 			showChannelLabels = append(showChannelLabels, c)
 		}
 	}
-	return showChannelLabels, nil
+	return page.Slice(showChannelLabels, req, "channel_labels.show")
+}
+
+func (im *impl) GetChannelLabelByLabel(ctx context.Context, label int32) (*channelDTO.ChannelLabelDTO, error) {
+	logPos := "[channel_label.app.service][GetChannelLabelByLabel]"
+
+	ctx, span := tracing.Start(ctx, "channel_label.service.GetChannelLabelByLabel")
+	defer span.End()
+
+	channelLabel, err := im.channelLabelStore.GetChannelLabelByLabel(ctx, label)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":   logPos,
+			"label": label,
+		}).Error("channelLabelStore.GetChannelLabelByLabel failed: ", err)
+		return nil, err
+	}
+
+	if channelLabel == nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":   logPos,
+			"label": label,
+		}).Error("Cannot find channel label")
+		return nil, errs.NewNotFound("Cannot find channel label")
+	}
+
+	return channelLabel, nil
+}
+
+func (im *impl) ModifyChannelLabel(ctx context.Context, channelLabel *channelDTO.ChannelLabelDTO) (*channelDTO.ChannelLabelDTO, error) {
+	logPos := "[channel_label.app.service][ModifyChannelLabel]"
+
+	ctx, span := tracing.Start(ctx, "channel_label.service.ModifyChannelLabel")
+	defer span.End()
+
+	if err := channelLabel.Validate(); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":   logPos,
+			"label": channelLabel.Label,
+		}).Error("channelLabel.Validate failed: ", err)
+		return nil, errs.Wrap(errs.InvalidArgument, err)
+	}
+
+	if err := im.channelLabelStore.ModifiedChannelLabel(ctx, channelLabel); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":   logPos,
+			"label": channelLabel.Label,
+		}).Error("channelLabelStore.ModifiedChannelLabel failed: ", err)
+		return nil, err
+	}
+
+	return channelLabel, nil
+}
+
+func (im *impl) UpdateChannelLabelShow(ctx context.Context, label int32, show int32) (*channelDTO.ChannelLabelDTO, error) {
+	logPos := "[channel_label.app.service][UpdateChannelLabelShow]"
+
+	ctx, span := tracing.Start(ctx, "channel_label.service.UpdateChannelLabelShow")
+	defer span.End()
+
+	channelLabel, err := im.GetChannelLabelByLabel(ctx, label)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":   logPos,
+			"label": label,
+		}).Error("GetChannelLabelByLabel failed: ", err)
+		return nil, err
+	}
+
+	channelLabel.Show = show
+
+	return im.ModifyChannelLabel(ctx, channelLabel)
 }
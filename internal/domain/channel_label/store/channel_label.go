@@ -10,6 +10,7 @@ import (
 	"go.uber.org/dig"
 
 	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
 
 	channelDTO "pickrewardapi/internal/domain/channel_label/dto"
 )
@@ -52,7 +53,10 @@ var MODIFIED_CHANNEL_LABEL_STAT = fmt.Sprintf(
 func (im *impl) ModifiedChannelLabel(ctx context.Context, channelLabelDTO *channelDTO.ChannelLabelDTO) error {
 	logPos := "[channel_label.store][ModifiedChannelLabel]"
 
-	tx, err := im.primary.Begin()
+	ctx, span := tracing.StartQuery(ctx, "channel_label.store.ModifiedChannelLabel", "MODIFIED_CHANNEL_LABEL_STAT")
+	defer span.End()
+
+	tx, err := im.primary.BeginEx(ctx, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos":                   logPos,
@@ -80,7 +84,7 @@ func (im *impl) ModifiedChannelLabel(ctx context.Context, channelLabelDTO *chann
 		channelLabelDTO.Show,
 	}
 
-	if _, err := tx.Exec(MODIFIED_CHANNEL_LABEL_STAT, updater...); err != nil {
+	if _, err = tx.ExecEx(ctx, MODIFIED_CHANNEL_LABEL_STAT, nil, updater...); err != nil {
 		log.WithFields(log.Fields{
 			"pos":                   logPos,
 			"channelLabelDTO.Label": channelLabelDTO.Label,
@@ -88,7 +92,7 @@ func (im *impl) ModifiedChannelLabel(ctx context.Context, channelLabelDTO *chann
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err = tx.CommitEx(ctx); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Error("tx.Commit failed: ", err)
@@ -107,9 +111,12 @@ var SELECT_CHANNEL_LABELS_STAT = fmt.Sprintf(
 func (im *impl) GetAllChannelLabels(ctx context.Context) ([]*channelDTO.ChannelLabelDTO, error) {
 	logPos := "[channel_label.store][GetAllChannelLabels]"
 
+	ctx, span := tracing.StartQuery(ctx, "channel_label.store.GetAllChannelLabels", "SELECT_CHANNEL_LABELS_STAT")
+	defer span.End()
+
 	channelLabelDTOs := []*channelDTO.ChannelLabelDTO{}
 
-	rows, err := im.primary.Query(SELECT_CHANNEL_LABELS_STAT)
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNEL_LABELS_STAT, nil)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -146,11 +153,14 @@ var SELECT_CHANNEL_LABEL_BY_LABEL_STAT = fmt.Sprintf(
 )
 
 func (im *impl) GetChannelLabelByLabel(ctx context.Context, label int32) (*channelDTO.ChannelLabelDTO, error) {
-	logPos := "[channel_label.store][GetAllChannelLabels]"
+	logPos := "[channel_label.store][GetChannelLabelByLabel]"
+
+	ctx, span := tracing.StartQuery(ctx, "channel_label.store.GetChannelLabelByLabel", "SELECT_CHANNEL_LABEL_BY_LABEL_STAT")
+	defer span.End()
 
-	channelLabelDTO := &channelDTO.ChannelLabelDTO{}
+	var channelLabelDTO *channelDTO.ChannelLabelDTO
 
-	rows, err := im.primary.Query(SELECT_CHANNEL_LABEL_BY_LABEL_STAT, label)
+	rows, err := im.primary.QueryEx(ctx, SELECT_CHANNEL_LABEL_BY_LABEL_STAT, nil, label)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -160,6 +170,7 @@ func (im *impl) GetChannelLabelByLabel(ctx context.Context, label int32) (*chann
 	defer rows.Close()
 
 	for rows.Next() {
+		channelLabelDTO = &channelDTO.ChannelLabelDTO{}
 
 		selector := []interface{}{
 			&channelLabelDTO.Label,
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"pickrewardapi/internal/pkg/memdb"
+
+	channelDTO "pickrewardapi/internal/domain/channel_label/dto"
+)
+
+// memory is a ChannelLabelStore kept in memory, used by the demo mode
+// instead of Postgres.
+type memory struct {
+	labels *memdb.Table[int32, channelDTO.ChannelLabelDTO]
+}
+
+func NewMemory() ChannelLabelStore {
+	logPos := "[channel_label.store][NewMemory]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Info("init memory channel label store")
+
+	return &memory{
+		labels: memdb.NewTable[int32, channelDTO.ChannelLabelDTO](),
+	}
+}
+
+func (m *memory) ModifiedChannelLabel(ctx context.Context, channelLabelDTO *channelDTO.ChannelLabelDTO) error {
+	return m.labels.Put(channelLabelDTO.Label, channelLabelDTO)
+}
+
+func (m *memory) GetAllChannelLabels(ctx context.Context) ([]*channelDTO.ChannelLabelDTO, error) {
+	labels, err := m.labels.Select(memdb.All[channelDTO.ChannelLabelDTO])
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Label < labels[j].Label
+	})
+
+	return labels, nil
+}
+
+func (m *memory) GetChannelLabelByLabel(ctx context.Context, label int32) (*channelDTO.ChannelLabelDTO, error) {
+	return m.labels.Get(label)
+}
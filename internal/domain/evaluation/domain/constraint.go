@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"time"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+type ConstraintStatus int32
+
+const (
+	Satisfied     ConstraintStatus = iota // 符合
+	Blocked                               // 不符合
+	RequireAction                         // 需使用者確認或登錄
+)
+
+// constraintEvaluator tells whether the event of scope meets constraint of
+// reward.
+type constraintEvaluator func(scope *EventScope, reward *rewardDTO.RewardDTO, constraint *commonM.Constraint, ledger *Ledger) ConstraintStatus
+
+var constraintEvaluators = map[commonM.ConstraintType]constraintEvaluator{
+	commonM.NewCustomer:  evaluateNewCustomer,
+	commonM.Register:     evaluateRegister,
+	commonM.LimitCount:   evaluateLimitCount,
+	commonM.LimitWeekDay: evaluateLimitWeekDay,
+}
+
+// evaluateNewCustomer needs the user to tell whether they are a new customer
+// of the card.
+func evaluateNewCustomer(scope *EventScope, reward *rewardDTO.RewardDTO, constraint *commonM.Constraint, ledger *Ledger) ConstraintStatus {
+	newCustomer, ok := scope.NewCustomerCardIDs[reward.CardID]
+	if !ok {
+		return RequireAction
+	}
+	if !newCustomer {
+		return Blocked
+	}
+	return Satisfied
+}
+
+// evaluateRegister needs the user to have registered the reward.
+func evaluateRegister(scope *EventScope, reward *rewardDTO.RewardDTO, constraint *commonM.Constraint, ledger *Ledger) ConstraintStatus {
+	if !scope.RegisteredRewardIDs[reward.ID] {
+		return RequireAction
+	}
+	return Satisfied
+}
+
+// evaluateLimitCount limits how many times the reward is given per period, a
+// reward without a known count is first come first served and cannot be
+// promised.
+func evaluateLimitCount(scope *EventScope, reward *rewardDTO.RewardDTO, constraint *commonM.Constraint, ledger *Ledger) ConstraintStatus {
+	if constraint.LimitCount <= 0 {
+		return RequireAction
+	}
+
+	period := PeriodKey(reward.Rule.PeriodType, scope.Date)
+	if ledger.RewardCount(reward.ID, period) >= constraint.LimitCount {
+		return Blocked
+	}
+	return Satisfied
+}
+
+// evaluateLimitWeekDay needs the event to happen on one of the week days,
+// 0 is Sunday.
+func evaluateLimitWeekDay(scope *EventScope, reward *rewardDTO.RewardDTO, constraint *commonM.Constraint, ledger *Ledger) ConstraintStatus {
+	weekDay := int32(time.Unix(scope.Date, 0).In(location).Weekday())
+	for _, d := range constraint.WeekDays {
+		if d == weekDay {
+			return Satisfied
+		}
+	}
+	return Blocked
+}
+
+// evaluateConstraints evaluates every constraint of reward, the reward is
+// blocked by any blocked constraint and otherwise needs an action when any
+// constraint does.
+func evaluateConstraints(scope *EventScope, reward *rewardDTO.RewardDTO, ledger *Ledger) ([]*evaluationDTO.ConstraintEvaluationDTO, ConstraintStatus) {
+
+	constraintEvaluations := []*evaluationDTO.ConstraintEvaluationDTO{}
+	status := Satisfied
+
+	for _, c := range reward.Rule.Constraints {
+		evaluate, ok := constraintEvaluators[c.ConstraintType]
+		if !ok {
+			continue
+		}
+
+		constraintStatus := evaluate(scope, reward, c, ledger)
+
+		constraintName := c.ConstraintName
+		if constraint, err := commonM.GetConstraintType(int32(c.ConstraintType)); err == nil {
+			constraintName = constraint.ConstraintName
+		}
+
+		constraintEvaluations = append(constraintEvaluations, &evaluationDTO.ConstraintEvaluationDTO{
+			ConstraintType:   int32(c.ConstraintType),
+			ConstraintName:   constraintName,
+			ConstraintStatus: int32(constraintStatus),
+		})
+
+		switch {
+		case constraintStatus == Blocked:
+			status = Blocked
+		case constraintStatus == RequireAction && status == Satisfied:
+			status = RequireAction
+		}
+	}
+
+	return constraintEvaluations, status
+}
@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"slices"
+	"sort"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// EventScope flattens a commonM.Event into the channel IDs, channel labels
+// and pay IDs it touches, so every reward of a card can be matched against it.
+type EventScope struct {
+	Date int64
+	Cost int32
+
+	ChannelIDs    map[string]bool
+	ChannelLabels map[int32]bool
+
+	PayStatus commonM.PayStatus
+	PayIDs    map[string]bool
+
+	RewardType commonM.RewardType
+
+	NewCustomerCardIDs  map[string]bool
+	RegisteredRewardIDs map[string]bool
+
+	// RewardStatuses are the statuses of the rewards evaluated, active
+	// unless the caller previews the pilot ones.
+	RewardStatuses []commonM.Status
+}
+
+// NewEventScope builds the scope of event, channelLabels holds the labels
+// stored on each channel so the caller does not have to send them.
+func NewEventScope(event *commonM.Event, channelLabels map[string][]int32) *EventScope {
+
+	scope := &EventScope{
+		Date:          event.Date,
+		Cost:          event.Cost,
+		ChannelIDs:    map[string]bool{},
+		ChannelLabels: map[int32]bool{},
+		PayStatus:     commonM.Whatever,
+		PayIDs:        map[string]bool{},
+		RewardType:    commonM.NONE_REWARD,
+
+		NewCustomerCardIDs:  map[string]bool{},
+		RegisteredRewardIDs: map[string]bool{},
+
+		RewardStatuses: []commonM.Status{commonM.Active},
+	}
+
+	if event.ChannelEvent != nil {
+		for label, ok := range event.ChannelEvent.ChannelLabels {
+			if ok {
+				scope.ChannelLabels[label] = true
+			}
+		}
+
+		for _, c := range event.ChannelEvent.ChannelIDs {
+			if c == nil {
+				continue
+			}
+			scope.ChannelIDs[c.ChannelID] = true
+
+			for label, ok := range c.ChannelLabels {
+				if ok {
+					scope.ChannelLabels[label] = true
+				}
+			}
+
+			for _, label := range channelLabels[c.ChannelID] {
+				scope.ChannelLabels[label] = true
+			}
+		}
+	}
+
+	if event.PayEvent != nil {
+		scope.PayStatus = event.PayEvent.Status
+		for payID, ok := range event.PayEvent.PayIDs {
+			if ok {
+				scope.PayIDs[payID] = true
+			}
+		}
+	}
+
+	if event.CardEvent != nil {
+		scope.RewardType = commonM.RewardType(event.CardEvent.RewardType)
+	}
+
+	if event.UserEvent != nil {
+		for cardID, newCustomer := range event.UserEvent.NewCustomerCardIDs {
+			scope.NewCustomerCardIDs[cardID] = newCustomer
+		}
+		for rewardID, ok := range event.UserEvent.RegisteredRewardIDs {
+			if ok {
+				scope.RegisteredRewardIDs[rewardID] = true
+			}
+		}
+	}
+
+	return scope
+}
+
+// EvaluateReward returns what reward gives back for the scope, or nil when the
+// reward does not apply to it. The ledger holds what earlier events already
+// consumed of the reward's period cap and threshold, nil means none.
+func EvaluateReward(scope *EventScope, reward *rewardDTO.RewardDTO, ledger *Ledger) *evaluationDTO.RewardEvaluationDTO {
+
+	if reward.Rule == nil {
+		return nil
+	}
+
+	if !slices.Contains(scope.RewardStatuses, reward.RewardStatus) || !reward.IsActiveAt(scope.Date) {
+		return nil
+	}
+
+	if scope.RewardType != commonM.NONE_REWARD && commonM.RewardType(reward.RewardType) != scope.RewardType {
+		return nil
+	}
+
+	matchedChannelIDs, matchedChannelLabels, ok := matchChannels(scope, reward.Rule)
+	if !ok {
+		return nil
+	}
+
+	matchedPayIDs, ok := matchPays(scope, reward.Rule)
+	if !ok {
+		return nil
+	}
+
+	if scope.Cost < reward.Rule.MinCost {
+		return nil
+	}
+
+	period := PeriodKey(reward.Rule.PeriodType, scope.Date)
+
+	if reward.Rule.PeriodMinCost > 0 &&
+		ledger.CardCost(reward.CardID, period)+int64(scope.Cost) < int64(reward.Rule.PeriodMinCost) {
+		return nil
+	}
+
+	amount, capped := reward.Rule.Calculate(scope.Cost)
+	if reward.Rule.PeriodCap > 0 {
+		remaining := reward.Rule.PeriodCap - ledger.RewardAmount(reward.ID, period)
+		if remaining < 0 {
+			remaining = 0
+		}
+		if amount > remaining {
+			amount = remaining
+			capped = true
+		}
+	}
+
+	constraintEvaluations, constraintStatus := evaluateConstraints(scope, reward, ledger)
+
+	return &evaluationDTO.RewardEvaluationDTO{
+		RewardID:             reward.ID,
+		Name:                 reward.Name,
+		RewardType:           reward.RewardType,
+		CalculateType:        int32(reward.Rule.CalculateType),
+		Percentage:           reward.Rule.Percentage,
+		Fixed:                reward.Rule.Fixed,
+		Amount:               amount,
+		Capped:               capped,
+		MatchedChannelIDs:    matchedChannelIDs,
+		MatchedChannelLabels: matchedChannelLabels,
+		MatchedPayIDs:        matchedPayIDs,
+
+		ConstraintStatus:      int32(constraintStatus),
+		ConstraintEvaluations: constraintEvaluations,
+	}
+}
+
+// Evaluate sums up every reward of cardID that applies to the scope.
+func Evaluate(cardID, eventID string, scope *EventScope, rewards []*rewardDTO.RewardDTO, ledger *Ledger) *evaluationDTO.EvaluationDTO {
+
+	evaluation := &evaluationDTO.EvaluationDTO{
+		CardID:            cardID,
+		EventID:           eventID,
+		Date:              scope.Date,
+		Cost:              scope.Cost,
+		RewardEvaluations: []*evaluationDTO.RewardEvaluationDTO{},
+	}
+
+	for _, r := range rewards {
+		rewardEvaluation := EvaluateReward(scope, r, ledger)
+		if rewardEvaluation == nil {
+			continue
+		}
+
+		if ConstraintStatus(rewardEvaluation.ConstraintStatus) == Satisfied {
+			switch commonM.RewardType(rewardEvaluation.RewardType) {
+			case commonM.CASH:
+				evaluation.Cash += rewardEvaluation.Amount
+			case commonM.POINT:
+				evaluation.Point += rewardEvaluation.Amount
+			}
+		}
+
+		evaluation.RewardEvaluations = append(evaluation.RewardEvaluations, rewardEvaluation)
+	}
+
+	return evaluation
+}
+
+// Amount is what the evaluation gives back in total, a point is counted as
+// one dollar so cash and point rewards can be compared.
+func Amount(evaluation *evaluationDTO.EvaluationDTO) float64 {
+	return evaluation.Cash + evaluation.Point
+}
+
+// Rate is the percentage of the cost the evaluation gives back.
+func Rate(evaluation *evaluationDTO.EvaluationDTO) float64 {
+	if evaluation.Cost <= 0 {
+		return 0
+	}
+	return Amount(evaluation) / float64(evaluation.Cost) * 100
+}
+
+// matchChannels matches the rule's target channels and labels, a rule without
+// any target or targeting commonM.All applies to every channel.
+func matchChannels(scope *EventScope, rule *rewardDTO.RewardRuleDTO) ([]string, []int32, bool) {
+
+	if len(rule.ChannelIDs) == 0 && len(rule.ChannelLabels) == 0 {
+		return []string{}, []int32{}, true
+	}
+
+	matchedChannelIDs := []string{}
+	for _, ID := range rule.ChannelIDs {
+		if scope.ChannelIDs[ID] {
+			matchedChannelIDs = append(matchedChannelIDs, ID)
+		}
+	}
+
+	matchedChannelLabels := []int32{}
+	for _, label := range rule.ChannelLabels {
+		if label == int32(commonM.All) || scope.ChannelLabels[label] {
+			matchedChannelLabels = append(matchedChannelLabels, label)
+		}
+	}
+
+	sort.Strings(matchedChannelIDs)
+	sort.Slice(matchedChannelLabels, func(i, j int) bool {
+		return matchedChannelLabels[i] < matchedChannelLabels[j]
+	})
+
+	ok := len(matchedChannelIDs) > 0 || len(matchedChannelLabels) > 0
+	return matchedChannelIDs, matchedChannelLabels, ok
+}
+
+// matchPays matches the rule's pay methods, a rule without any pay method
+// applies whatever the event pays with.
+func matchPays(scope *EventScope, rule *rewardDTO.RewardRuleDTO) ([]string, bool) {
+
+	if len(rule.PayIDs) == 0 {
+		return []string{}, true
+	}
+
+	if scope.PayStatus == commonM.No {
+		return nil, false
+	}
+
+	matchedPayIDs := []string{}
+	for _, ID := range rule.PayIDs {
+		if scope.PayIDs[ID] {
+			matchedPayIDs = append(matchedPayIDs, ID)
+		}
+	}
+
+	sort.Strings(matchedPayIDs)
+	return matchedPayIDs, len(matchedPayIDs) > 0
+}
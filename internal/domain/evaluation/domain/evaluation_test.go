@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// may10 is noon of 10 May 2024 where the cards are issued.
+var may10 = time.Date(2024, time.May, 10, 12, 0, 0, 0, location).Unix()
+
+func newReward(ID, cardID string, rule *rewardDTO.RewardRuleDTO) *rewardDTO.RewardDTO {
+	return &rewardDTO.RewardDTO{
+		ID:           ID,
+		CardID:       cardID,
+		Name:         ID,
+		RewardType:   int32(commonM.CASH),
+		Rule:         rule,
+		RewardStatus: commonM.Active,
+	}
+}
+
+func percentage(p float64) *rewardDTO.RewardRuleDTO {
+	return &rewardDTO.RewardRuleDTO{CalculateType: rewardDTO.Percentage, Percentage: p}
+}
+
+func newEvent(cost int32) *commonM.Event {
+	return &commonM.Event{Date: may10, Cost: cost}
+}
+
+func TestEvaluateReward(t *testing.T) {
+
+	channelLabels := map[string][]int32{
+		"abroad": {int32(commonM.Oversea)},
+	}
+
+	tests := []struct {
+		name       string
+		reward     func() *rewardDTO.RewardDTO
+		event      func() *commonM.Event
+		wantNil    bool
+		wantAmount float64
+	}{
+		{
+			name:       "rule without targets applies to every event",
+			reward:     func() *rewardDTO.RewardDTO { return newReward("r", "c", percentage(3)) },
+			event:      func() *commonM.Event { return newEvent(1000) },
+			wantAmount: 30,
+		},
+		{
+			name: "reward without a rule",
+			reward: func() *rewardDTO.RewardDTO {
+				return newReward("r", "c", nil)
+			},
+			event:   func() *commonM.Event { return newEvent(1000) },
+			wantNil: true,
+		},
+		{
+			name: "matched channel id",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(5)
+				rule.ChannelIDs = []string{"shop"}
+				return newReward("r", "c", rule)
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.ChannelEvent = &commonM.ChannelEvent{ChannelIDs: []*commonM.ChannelIDEvent{{ChannelID: "shop"}}}
+				return e
+			},
+			wantAmount: 50,
+		},
+		{
+			name: "other channel id",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(5)
+				rule.ChannelIDs = []string{"shop"}
+				return newReward("r", "c", rule)
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.ChannelEvent = &commonM.ChannelEvent{ChannelIDs: []*commonM.ChannelIDEvent{{ChannelID: "market"}}}
+				return e
+			},
+			wantNil: true,
+		},
+		{
+			name: "label stored on the channel",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(2)
+				rule.ChannelLabels = []int32{int32(commonM.Oversea)}
+				return newReward("r", "c", rule)
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.ChannelEvent = &commonM.ChannelEvent{ChannelIDs: []*commonM.ChannelIDEvent{{ChannelID: "abroad"}}}
+				return e
+			},
+			wantAmount: 20,
+		},
+		{
+			name: "label all applies to every channel",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(1)
+				rule.ChannelLabels = []int32{int32(commonM.All)}
+				return newReward("r", "c", rule)
+			},
+			event:      func() *commonM.Event { return newEvent(1000) },
+			wantAmount: 10,
+		},
+		{
+			name: "matched pay id",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(4)
+				rule.PayIDs = []string{"wallet"}
+				return newReward("r", "c", rule)
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.PayEvent = &commonM.PayEvent{Status: commonM.Use, PayIDs: map[string]bool{"wallet": true}}
+				return e
+			},
+			wantAmount: 40,
+		},
+		{
+			name: "event paid without a pay method",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(4)
+				rule.PayIDs = []string{"wallet"}
+				return newReward("r", "c", rule)
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.PayEvent = &commonM.PayEvent{Status: commonM.No}
+				return e
+			},
+			wantNil: true,
+		},
+		{
+			name: "inactive reward",
+			reward: func() *rewardDTO.RewardDTO {
+				r := newReward("r", "c", percentage(3))
+				r.RewardStatus = commonM.Inactive
+				return r
+			},
+			event:   func() *commonM.Event { return newEvent(1000) },
+			wantNil: true,
+		},
+		{
+			name: "event after the reward ends",
+			reward: func() *rewardDTO.RewardDTO {
+				r := newReward("r", "c", percentage(3))
+				r.EndDate = may10 - 1
+				return r
+			},
+			event:   func() *commonM.Event { return newEvent(1000) },
+			wantNil: true,
+		},
+		{
+			name: "event asking for another reward type",
+			reward: func() *rewardDTO.RewardDTO {
+				return newReward("r", "c", percentage(3))
+			},
+			event: func() *commonM.Event {
+				e := newEvent(1000)
+				e.CardEvent = &commonM.CardEvent{RewardType: int32(commonM.POINT)}
+				return e
+			},
+			wantNil: true,
+		},
+		{
+			name: "cost under the min cost",
+			reward: func() *rewardDTO.RewardDTO {
+				rule := percentage(3)
+				rule.MinCost = 2000
+				return newReward("r", "c", rule)
+			},
+			event:   func() *commonM.Event { return newEvent(1000) },
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := NewEventScope(tt.event(), channelLabels)
+
+			got := EvaluateReward(scope, tt.reward(), nil)
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("EvaluateReward() = %+v, want nil", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatal("EvaluateReward() = nil")
+			}
+			if got.Amount != tt.wantAmount {
+				t.Errorf("Amount = %v, want %v", got.Amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+
+	point := newReward("point", "c", percentage(2))
+	point.RewardType = int32(commonM.POINT)
+
+	registered := newReward("registered", "c", percentage(10))
+	registered.Rule.Constraints = []*commonM.Constraint{{ConstraintType: commonM.Register}}
+
+	rewards := []*rewardDTO.RewardDTO{
+		newReward("cash", "c", percentage(1)),
+		point,
+		registered,
+	}
+
+	evaluation := Evaluate("c", "e", NewEventScope(newEvent(1000), nil), rewards, nil)
+
+	if evaluation.Cash != 10 {
+		t.Errorf("Cash = %v, want 10", evaluation.Cash)
+	}
+	if evaluation.Point != 20 {
+		t.Errorf("Point = %v, want 20", evaluation.Point)
+	}
+	if len(evaluation.RewardEvaluations) != 3 {
+		t.Fatalf("got %d reward evaluations, want 3", len(evaluation.RewardEvaluations))
+	}
+	if s := ConstraintStatus(evaluation.RewardEvaluations[2].ConstraintStatus); s != RequireAction {
+		t.Errorf("unregistered reward status = %v, want RequireAction", s)
+	}
+	if rate := Rate(evaluation); rate != 3 {
+		t.Errorf("Rate = %v, want 3", rate)
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+)
+
+// location is where the cards are issued, periods and week days follow it.
+var location = time.FixedZone("Asia/Taipei", 8*60*60)
+
+// PeriodKey returns the period of date for periodType.
+func PeriodKey(periodType rewardDTO.PeriodType, date int64) string {
+	switch periodType {
+	case rewardDTO.CampaignPeriod:
+		return "campaign"
+	default:
+		return time.Unix(date, 0).In(location).Format("2006-01")
+	}
+}
+
+// Ledger keeps what earlier events of a simulation consumed, that is the
+// spend on each card and the reward given by each reward per period. A nil
+// Ledger is an empty one.
+type Ledger struct {
+	cardCosts     map[string]int64
+	rewardAmounts map[string]float64
+	rewardCounts  map[string]int32
+}
+
+func NewLedger() *Ledger {
+	return &Ledger{
+		cardCosts:     map[string]int64{},
+		rewardAmounts: map[string]float64{},
+		rewardCounts:  map[string]int32{},
+	}
+}
+
+func (l *Ledger) CardCost(cardID, period string) int64 {
+	if l == nil {
+		return 0
+	}
+	return l.cardCosts[cardID+"/"+period]
+}
+
+func (l *Ledger) RewardAmount(rewardID, period string) float64 {
+	if l == nil {
+		return 0
+	}
+	return l.rewardAmounts[rewardID+"/"+period]
+}
+
+func (l *Ledger) RewardCount(rewardID, period string) int32 {
+	if l == nil {
+		return 0
+	}
+	return l.rewardCounts[rewardID+"/"+period]
+}
+
+// Consume records that the event of scope is paid by the evaluated card, a nil
+// Ledger records nothing.
+func (l *Ledger) Consume(scope *EventScope, evaluation *evaluationDTO.EvaluationDTO, rewards []*rewardDTO.RewardDTO) {
+	if l == nil {
+		return
+	}
+
+	for _, periodType := range []rewardDTO.PeriodType{rewardDTO.MonthPeriod, rewardDTO.CampaignPeriod} {
+		l.cardCosts[evaluation.CardID+"/"+PeriodKey(periodType, scope.Date)] += int64(scope.Cost)
+	}
+
+	periodTypes := map[string]rewardDTO.PeriodType{}
+	for _, r := range rewards {
+		if r.Rule != nil {
+			periodTypes[r.ID] = r.Rule.PeriodType
+		}
+	}
+
+	for _, r := range evaluation.RewardEvaluations {
+		if ConstraintStatus(r.ConstraintStatus) != Satisfied {
+			continue
+		}
+
+		period := PeriodKey(periodTypes[r.RewardID], scope.Date)
+		l.rewardAmounts[r.RewardID+"/"+period] += r.Amount
+		l.rewardCounts[r.RewardID+"/"+period]++
+	}
+}
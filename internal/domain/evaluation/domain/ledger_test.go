@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// consume records an earlier event of cost on cardID that got amount from
+// reward.
+func consume(ledger *Ledger, cardID string, date int64, cost int32, reward *rewardDTO.RewardDTO, amount float64) {
+	scope := NewEventScope(&commonM.Event{Date: date, Cost: cost}, nil)
+
+	evaluation := &evaluationDTO.EvaluationDTO{
+		CardID: cardID,
+		RewardEvaluations: []*evaluationDTO.RewardEvaluationDTO{
+			{RewardID: reward.ID, Amount: amount, ConstraintStatus: int32(Satisfied)},
+		},
+	}
+
+	ledger.Consume(scope, evaluation, []*rewardDTO.RewardDTO{reward})
+}
+
+func TestEvaluateRewardPeriodCap(t *testing.T) {
+
+	april10 := time.Date(2024, time.April, 10, 12, 0, 0, 0, location).Unix()
+
+	tests := []struct {
+		name       string
+		periodType rewardDTO.PeriodType
+		consumed   float64
+		consumedAt int64
+		wantAmount float64
+		wantCapped bool
+	}{
+		{name: "nothing consumed", consumedAt: may10, wantAmount: 100},
+		{name: "cap partly consumed", consumed: 100, consumedAt: may10, wantAmount: 50, wantCapped: true},
+		{name: "cap fully consumed", consumed: 150, consumedAt: may10, wantAmount: 0, wantCapped: true},
+		{name: "cap consumed last month", consumed: 150, consumedAt: april10, wantAmount: 100},
+		{
+			name:       "campaign cap consumed last month",
+			periodType: rewardDTO.CampaignPeriod,
+			consumed:   100,
+			consumedAt: april10,
+			wantAmount: 50,
+			wantCapped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := percentage(10)
+			rule.PeriodCap = 150
+			rule.PeriodType = tt.periodType
+			reward := newReward("r", "c", rule)
+
+			ledger := NewLedger()
+			if tt.consumed > 0 {
+				consume(ledger, "c", tt.consumedAt, 1000, reward, tt.consumed)
+			}
+
+			got := EvaluateReward(NewEventScope(newEvent(1000), nil), reward, ledger)
+			if got == nil {
+				t.Fatal("EvaluateReward() = nil")
+			}
+			if got.Amount != tt.wantAmount || got.Capped != tt.wantCapped {
+				t.Errorf("EvaluateReward() = %v, %v, want %v, %v", got.Amount, got.Capped, tt.wantAmount, tt.wantCapped)
+			}
+		})
+	}
+}
+
+func TestEvaluateRewardPeriodMinCost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		minCost int32
+		spent   int32
+		cost    int32
+		wantNil bool
+	}{
+		{name: "threshold not reached", minCost: 5000, spent: 3000, cost: 1000, wantNil: true},
+		{name: "threshold reached with the event", minCost: 5000, spent: 4000, cost: 1000},
+		{name: "threshold passed before", minCost: 5000, spent: 6000, cost: 1000},
+		{name: "event alone reaches the threshold", minCost: 1000, cost: 1000},
+		{name: "no threshold", minCost: 0, cost: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := percentage(10)
+			rule.PeriodMinCost = tt.minCost
+			reward := newReward("r", "c", rule)
+
+			ledger := NewLedger()
+			if tt.spent > 0 {
+				consume(ledger, "c", may10, tt.spent, reward, 0)
+			}
+
+			got := EvaluateReward(NewEventScope(newEvent(tt.cost), nil), reward, ledger)
+			if (got == nil) != tt.wantNil {
+				t.Errorf("EvaluateReward() = %+v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestEvaluateRewardLimitCount(t *testing.T) {
+
+	rule := percentage(10)
+	rule.Constraints = []*commonM.Constraint{{ConstraintType: commonM.LimitCount, LimitCount: 2}}
+	reward := newReward("r", "c", rule)
+
+	ledger := NewLedger()
+	for i, want := range []ConstraintStatus{Satisfied, Satisfied, Blocked} {
+		got := EvaluateReward(NewEventScope(newEvent(1000), nil), reward, ledger)
+		if s := ConstraintStatus(got.ConstraintStatus); s != want {
+			t.Fatalf("event %d: constraint status = %v, want %v", i, s, want)
+		}
+		consume(ledger, "c", may10, 1000, reward, got.Amount)
+	}
+}
+
+func TestLedgerConsume(t *testing.T) {
+
+	monthly := newReward("monthly", "c", percentage(1))
+	campaign := newReward("campaign", "c", percentage(1))
+	campaign.Rule.PeriodType = rewardDTO.CampaignPeriod
+
+	scope := NewEventScope(newEvent(1000), nil)
+	evaluation := &evaluationDTO.EvaluationDTO{
+		CardID: "c",
+		RewardEvaluations: []*evaluationDTO.RewardEvaluationDTO{
+			{RewardID: "monthly", Amount: 10, ConstraintStatus: int32(Satisfied)},
+			{RewardID: "campaign", Amount: 20, ConstraintStatus: int32(Satisfied)},
+			{RewardID: "blocked", Amount: 30, ConstraintStatus: int32(Blocked)},
+		},
+	}
+
+	ledger := NewLedger()
+	ledger.Consume(scope, evaluation, []*rewardDTO.RewardDTO{monthly, campaign})
+
+	month := PeriodKey(rewardDTO.MonthPeriod, may10)
+	camp := PeriodKey(rewardDTO.CampaignPeriod, may10)
+
+	if got := ledger.CardCost("c", month); got != 1000 {
+		t.Errorf("monthly card cost = %d, want 1000", got)
+	}
+	if got := ledger.CardCost("c", camp); got != 1000 {
+		t.Errorf("campaign card cost = %d, want 1000", got)
+	}
+	if got := ledger.RewardAmount("monthly", month); got != 10 {
+		t.Errorf("monthly reward amount = %v, want 10", got)
+	}
+	if got := ledger.RewardAmount("campaign", camp); got != 20 {
+		t.Errorf("campaign reward amount = %v, want 20", got)
+	}
+	if got := ledger.RewardCount("blocked", month); got != 0 {
+		t.Errorf("blocked reward count = %d, want 0", got)
+	}
+
+	var empty *Ledger
+	empty.Consume(scope, evaluation, nil)
+	if got := empty.CardCost("c", month); got != 0 {
+		t.Errorf("nil ledger card cost = %d, want 0", got)
+	}
+}
@@ -0,0 +1,78 @@
+package dto
+
+type EvaluationDTO struct {
+	CardID  string `json:"cardID"`
+	EventID string `json:"eventID"`
+	Date    int64  `json:"date"`
+	Cost    int32  `json:"cost"`
+
+	Cash  float64 `json:"cash"`
+	Point float64 `json:"point"`
+
+	RewardEvaluations []*RewardEvaluationDTO `json:"rewardEvaluations"`
+}
+
+type RewardEvaluationDTO struct {
+	RewardID      string  `json:"rewardID"`
+	Name          string  `json:"name"`
+	RewardType    int32   `json:"rewardType"`
+	CalculateType int32   `json:"calculateType"`
+	Percentage    float64 `json:"percentage"`
+	Fixed         float64 `json:"fixed"`
+	Amount        float64 `json:"amount"`
+	Capped        bool    `json:"capped"`
+
+	MatchedChannelIDs    []string `json:"matchedChannelIDs"`
+	MatchedChannelLabels []int32  `json:"matchedChannelLabels"`
+	MatchedPayIDs        []string `json:"matchedPayIDs"`
+
+	// ConstraintStatus sums up the constraints, only a satisfied reward is
+	// counted in the evaluation's cash and point.
+	ConstraintStatus      int32                      `json:"constraintStatus"`
+	ConstraintEvaluations []*ConstraintEvaluationDTO `json:"constraintEvaluations"`
+}
+
+type ConstraintEvaluationDTO struct {
+	ConstraintType   int32  `json:"constraintType"`
+	ConstraintName   string `json:"constraintName"`
+	ConstraintStatus int32  `json:"constraintStatus"`
+}
+
+type CardRankDTO struct {
+	CardID   string `json:"cardID"`
+	CardName string `json:"cardName"`
+	BankID   string `json:"bankID"`
+
+	Amount float64 `json:"amount"`
+	Rate   float64 `json:"rate"`
+
+	Evaluation *EvaluationDTO `json:"evaluation"`
+}
+
+type SimulationDTO struct {
+	EventSimulations []*EventSimulationDTO `json:"eventSimulations"`
+	CardSimulations  []*CardSimulationDTO  `json:"cardSimulations"`
+}
+
+type EventSimulationDTO struct {
+	EventID string `json:"eventID"`
+	Date    int64  `json:"date"`
+	Cost    int32  `json:"cost"`
+
+	CardID string  `json:"cardID"`
+	Amount float64 `json:"amount"`
+	Rate   float64 `json:"rate"`
+
+	Evaluation *EvaluationDTO `json:"evaluation"`
+}
+
+type CardSimulationDTO struct {
+	CardID   string `json:"cardID"`
+	CardName string `json:"cardName"`
+
+	EventCount int32   `json:"eventCount"`
+	Cost       int64   `json:"cost"`
+	Cash       float64 `json:"cash"`
+	Point      float64 `json:"point"`
+	Amount     float64 `json:"amount"`
+}
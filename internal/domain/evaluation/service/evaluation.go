@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	cardStore "pickrewardapi/internal/domain/card/store"
+	rewardStore "pickrewardapi/internal/domain/card_reward/store"
+	channelStore "pickrewardapi/internal/domain/channel/store"
+
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	evaluationDomain "pickrewardapi/internal/domain/evaluation/domain"
+	evaluationDTO "pickrewardapi/internal/domain/evaluation/dto"
+	"pickrewardapi/internal/shared/common/errs"
+	commonM "pickrewardapi/internal/shared/common/model"
+	"pickrewardapi/internal/shared/common/preview"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type EvaluationService interface {
+	EvaluateEvent(ctx context.Context, cardID string, event *commonM.Event) (*evaluationDTO.EvaluationDTO, error)
+	RankCards(ctx context.Context, event *commonM.Event) ([]*evaluationDTO.CardRankDTO, error)
+	SimulateEvents(ctx context.Context, events []*commonM.Event, cardIDs []string) (*evaluationDTO.SimulationDTO, error)
+}
+
+var (
+	timeNow = time.Now
+)
+
+type impl struct {
+	dig.In
+
+	cardStore    cardStore.CardStore
+	rewardStore  rewardStore.RewardStore
+	channelStore channelStore.ChannelStore
+}
+
+func New(
+	cardStore cardStore.CardStore,
+	rewardStore rewardStore.RewardStore,
+	channelStore channelStore.ChannelStore,
+) EvaluationService {
+
+	impl := &impl{
+		cardStore:    cardStore,
+		rewardStore:  rewardStore,
+		channelStore: channelStore,
+	}
+
+	return impl
+}
+
+func (im *impl) EvaluateEvent(ctx context.Context, cardID string, event *commonM.Event) (*evaluationDTO.EvaluationDTO, error) {
+	logPos := "[evaluation.service][EvaluateEvent]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.service.EvaluateEvent")
+	defer span.End()
+
+	if err := validateEvent(event); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validateEvent failed: ", err)
+		return nil, err
+	}
+
+	card, err := im.cardStore.GetByCardID(ctx, cardID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":    logPos,
+			"cardID": cardID,
+		}).Error("cardStore.GetByCardID failed: ", err)
+		return nil, err
+	}
+
+	if card == nil || !preview.Visible(ctx, card.CardStatus) {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":    logPos,
+			"cardID": cardID,
+		}).Error("Cannot find cardID")
+		return nil, errs.NewNotFound("Cannot find cardID")
+	}
+
+	rewards, err := im.rewardStore.GetRewardsByCardID(ctx, cardID)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos":    logPos,
+			"cardID": cardID,
+		}).Error("rewardStore.GetRewardsByCardID failed: ", err)
+		return nil, err
+	}
+
+	scope, err := im.newEventScope(ctx, event)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("newEventScope failed: ", err)
+		return nil, err
+	}
+
+	return evaluationDomain.Evaluate(card.ID, event.ID, scope, rewards, nil), nil
+}
+
+func (im *impl) RankCards(ctx context.Context, event *commonM.Event) ([]*evaluationDTO.CardRankDTO, error) {
+	logPos := "[evaluation.service][RankCards]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.service.RankCards")
+	defer span.End()
+
+	if err := validateEvent(event); err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("validateEvent failed: ", err)
+		return nil, err
+	}
+
+	cards, err := im.cardStore.GetAllCards(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardStore.GetAllCards failed: ", err)
+		return nil, err
+	}
+
+	rewards, err := im.rewardStore.GetAllRewards(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rewardStore.GetAllRewards failed: ", err)
+		return nil, err
+	}
+
+	rewardsByCardID := map[string][]*rewardDTO.RewardDTO{}
+	for _, r := range rewards {
+		rewardsByCardID[r.CardID] = append(rewardsByCardID[r.CardID], r)
+	}
+
+	scope, err := im.newEventScope(ctx, event)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("newEventScope failed: ", err)
+		return nil, err
+	}
+
+	cardRanks := []*evaluationDTO.CardRankDTO{}
+	cardOrders := map[string]int32{}
+	for _, c := range cards {
+		if !preview.Visible(ctx, c.CardStatus) {
+			continue
+		}
+
+		evaluation := evaluationDomain.Evaluate(c.ID, event.ID, scope, rewardsByCardID[c.ID], nil)
+		cardRanks = append(cardRanks, &evaluationDTO.CardRankDTO{
+			CardID:     c.ID,
+			CardName:   c.Name,
+			BankID:     c.BankID,
+			Amount:     evaluationDomain.Amount(evaluation),
+			Rate:       evaluationDomain.Rate(evaluation),
+			Evaluation: evaluation,
+		})
+		cardOrders[c.ID] = c.Order
+	}
+
+	sort.SliceStable(cardRanks, func(i, j int) bool {
+		if cardRanks[i].Amount != cardRanks[j].Amount {
+			return cardRanks[i].Amount > cardRanks[j].Amount
+		}
+		return cardOrders[cardRanks[i].CardID] < cardOrders[cardRanks[j].CardID]
+	})
+
+	return cardRanks, nil
+}
+
+func (im *impl) SimulateEvents(ctx context.Context, events []*commonM.Event, cardIDs []string) (*evaluationDTO.SimulationDTO, error) {
+	logPos := "[evaluation.service][SimulateEvents]"
+
+	ctx, span := tracing.Start(ctx, "evaluation.service.SimulateEvents")
+	defer span.End()
+
+	for _, e := range events {
+		if err := validateEvent(e); err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("validateEvent failed: ", err)
+			return nil, err
+		}
+	}
+
+	if len(cardIDs) == 0 {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("cardIDs is empty")
+		return nil, errs.NewInvalidArgument("cardIDs is empty")
+	}
+
+	cardSimulations := []*evaluationDTO.CardSimulationDTO{}
+	cardSimulationByCardID := map[string]*evaluationDTO.CardSimulationDTO{}
+	rewardsByCardID := map[string][]*rewardDTO.RewardDTO{}
+
+	for _, cardID := range cardIDs {
+		if _, ok := cardSimulationByCardID[cardID]; ok {
+			continue
+		}
+
+		card, err := im.cardStore.GetByCardID(ctx, cardID)
+		if err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos":    logPos,
+				"cardID": cardID,
+			}).Error("cardStore.GetByCardID failed: ", err)
+			return nil, err
+		}
+
+		if card == nil || !preview.Visible(ctx, card.CardStatus) {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos":    logPos,
+				"cardID": cardID,
+			}).Error("Cannot find cardID")
+			return nil, errs.NewNotFound("Cannot find cardID")
+		}
+
+		rewards, err := im.rewardStore.GetRewardsByCardID(ctx, cardID)
+		if err != nil {
+			baseCtx.FromContext(ctx).WithFields(log.Fields{
+				"pos":    logPos,
+				"cardID": cardID,
+			}).Error("rewardStore.GetRewardsByCardID failed: ", err)
+			return nil, err
+		}
+
+		cardSimulation := &evaluationDTO.CardSimulationDTO{
+			CardID:   card.ID,
+			CardName: card.Name,
+		}
+		cardSimulations = append(cardSimulations, cardSimulation)
+		cardSimulationByCardID[cardID] = cardSimulation
+		rewardsByCardID[cardID] = rewards
+	}
+
+	channelLabels, err := im.getChannelLabels(ctx, events...)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("getChannelLabels failed: ", err)
+		return nil, err
+	}
+
+	scopes := []*evaluationDomain.EventScope{}
+	for _, e := range events {
+		scope := evaluationDomain.NewEventScope(e, channelLabels)
+		if scope.Date == 0 {
+			scope.Date = timeNow().Unix()
+		}
+		scope.RewardStatuses = preview.Statuses(ctx)
+		scopes = append(scopes, scope)
+	}
+
+	// caps and thresholds are consumed in the order the events happened
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scopes[order[i]].Date < scopes[order[j]].Date
+	})
+
+	ledger := evaluationDomain.NewLedger()
+	eventSimulations := []*evaluationDTO.EventSimulationDTO{}
+
+	for _, i := range order {
+		event, scope := events[i], scopes[i]
+
+		var best *evaluationDTO.EvaluationDTO
+		for _, cardSimulation := range cardSimulations {
+			evaluation := evaluationDomain.Evaluate(cardSimulation.CardID, event.ID, scope, rewardsByCardID[cardSimulation.CardID], ledger)
+			if best == nil || evaluationDomain.Amount(evaluation) > evaluationDomain.Amount(best) {
+				best = evaluation
+			}
+		}
+
+		ledger.Consume(scope, best, rewardsByCardID[best.CardID])
+
+		cardSimulation := cardSimulationByCardID[best.CardID]
+		cardSimulation.EventCount++
+		cardSimulation.Cost += int64(scope.Cost)
+		cardSimulation.Cash += best.Cash
+		cardSimulation.Point += best.Point
+		cardSimulation.Amount += evaluationDomain.Amount(best)
+
+		eventSimulations = append(eventSimulations, &evaluationDTO.EventSimulationDTO{
+			EventID:    event.ID,
+			Date:       scope.Date,
+			Cost:       scope.Cost,
+			CardID:     best.CardID,
+			Amount:     evaluationDomain.Amount(best),
+			Rate:       evaluationDomain.Rate(best),
+			Evaluation: best,
+		})
+	}
+
+	return &evaluationDTO.SimulationDTO{
+		EventSimulations: eventSimulations,
+		CardSimulations:  cardSimulations,
+	}, nil
+}
+
+func validateEvent(event *commonM.Event) error {
+	if event == nil {
+		return errs.NewInvalidArgument("event is nil")
+	}
+
+	if event.Cost < 0 {
+		return errs.NewInvalidArgument("event cost is negative")
+	}
+
+	return nil
+}
+
+// newEventScope builds the scope of event with the labels stored on its
+// channels, an event without date is evaluated at the current time.
+func (im *impl) newEventScope(ctx context.Context, event *commonM.Event) (*evaluationDomain.EventScope, error) {
+
+	channelLabels, err := im.getChannelLabels(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := evaluationDomain.NewEventScope(event, channelLabels)
+	if scope.Date == 0 {
+		scope.Date = timeNow().Unix()
+	}
+	scope.RewardStatuses = preview.Statuses(ctx)
+
+	return scope, nil
+}
+
+// getChannelLabels loads the labels stored on every channel of events.
+func (im *impl) getChannelLabels(ctx context.Context, events ...*commonM.Event) (map[string][]int32, error) {
+
+	channelLabels := map[string][]int32{}
+
+	IDs := []string{}
+	for _, e := range events {
+		if e.ChannelEvent == nil {
+			continue
+		}
+		for _, c := range e.ChannelEvent.ChannelIDs {
+			if c != nil {
+				IDs = append(IDs, c.ChannelID)
+			}
+		}
+	}
+
+	if len(IDs) == 0 {
+		return channelLabels, nil
+	}
+
+	channelDTOs, err := im.channelStore.GetChannelByIDs(ctx, IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range channelDTOs {
+		channelLabels[c.ID] = c.ChannelLabels
+	}
+
+	return channelLabels, nil
+}
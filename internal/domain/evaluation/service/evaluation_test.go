@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	cardStore "pickrewardapi/internal/domain/card/store"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	rewardStore "pickrewardapi/internal/domain/card_reward/store"
+	channelStore "pickrewardapi/internal/domain/channel/store"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+func newService(t *testing.T, cards []*cardDTO.CardDTO, rewards []*rewardDTO.RewardDTO) EvaluationService {
+	t.Helper()
+
+	ctx := context.Background()
+
+	cs := cardStore.NewMemory()
+	for _, c := range cards {
+		if err := cs.ModifiedCard(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rs := rewardStore.NewMemory()
+	for _, r := range rewards {
+		if err := rs.ModifiedReward(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return New(cs, rs, channelStore.NewMemory())
+}
+
+func newCard(ID string) *cardDTO.CardDTO {
+	return &cardDTO.CardDTO{ID: ID, Name: ID, BankID: "bank", CardStatus: commonM.Active}
+}
+
+func newReward(ID, cardID string, percentage, periodCap float64) *rewardDTO.RewardDTO {
+	return &rewardDTO.RewardDTO{
+		ID:         ID,
+		CardID:     cardID,
+		Name:       ID,
+		RewardType: int32(commonM.CASH),
+		Rule: &rewardDTO.RewardRuleDTO{
+			CalculateType: rewardDTO.Percentage,
+			Percentage:    percentage,
+			PeriodCap:     periodCap,
+		},
+		RewardStatus: commonM.Active,
+	}
+}
+
+func TestSimulateEventsSwitchesCardsOnCap(t *testing.T) {
+
+	// capped gives 5% up to 100 a month, flat 2% without a cap.
+	svc := newService(t,
+		[]*cardDTO.CardDTO{newCard("capped"), newCard("flat")},
+		[]*rewardDTO.RewardDTO{
+			newReward("capped.reward", "capped", 5, 100),
+			newReward("flat.reward", "flat", 2, 0),
+		},
+	)
+
+	day := func(d int) int64 {
+		return time.Date(2024, time.May, d, 12, 0, 0, 0, time.UTC).Unix()
+	}
+
+	// sent out of order, the caps are consumed in the order of the dates
+	events := []*commonM.Event{
+		{ID: "third", Date: day(20), Cost: 1000},
+		{ID: "first", Date: day(1), Cost: 1000},
+		{ID: "fourth", Date: day(25), Cost: 1000},
+		{ID: "second", Date: day(10), Cost: 1000},
+	}
+
+	simulation, err := svc.SimulateEvents(context.Background(), events, []string{"capped", "flat"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		eventID string
+		cardID  string
+		amount  float64
+	}{
+		{eventID: "first", cardID: "capped", amount: 50},
+		{eventID: "second", cardID: "capped", amount: 50},
+		{eventID: "third", cardID: "flat", amount: 20},
+		{eventID: "fourth", cardID: "flat", amount: 20},
+	}
+
+	if len(simulation.EventSimulations) != len(want) {
+		t.Fatalf("got %d event simulations, want %d", len(simulation.EventSimulations), len(want))
+	}
+	for i, w := range want {
+		got := simulation.EventSimulations[i]
+		if got.EventID != w.eventID || got.CardID != w.cardID || got.Amount != w.amount {
+			t.Errorf("event %d = %s on %s for %v, want %s on %s for %v",
+				i, got.EventID, got.CardID, got.Amount, w.eventID, w.cardID, w.amount)
+		}
+	}
+
+	wantCards := map[string]struct {
+		eventCount int32
+		amount     float64
+	}{
+		"capped": {eventCount: 2, amount: 100},
+		"flat":   {eventCount: 2, amount: 40},
+	}
+	for _, c := range simulation.CardSimulations {
+		w := wantCards[c.CardID]
+		if c.EventCount != w.eventCount || c.Amount != w.amount {
+			t.Errorf("card %s = %d events for %v, want %d events for %v",
+				c.CardID, c.EventCount, c.Amount, w.eventCount, w.amount)
+		}
+	}
+}
+
+func TestSimulateEventsRejects(t *testing.T) {
+
+	svc := newService(t, []*cardDTO.CardDTO{newCard("card")}, nil)
+	events := []*commonM.Event{{ID: "e", Date: 1, Cost: 1000}}
+
+	tests := []struct {
+		name    string
+		events  []*commonM.Event
+		cardIDs []string
+	}{
+		{name: "no cards", events: events},
+		{name: "unknown card", events: events, cardIDs: []string{"other"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.SimulateEvents(context.Background(), tt.events, tt.cardIDs); err == nil {
+				t.Error("SimulateEvents() succeeded, want an error")
+			}
+		})
+	}
+}
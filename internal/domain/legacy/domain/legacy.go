@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"fmt"
+
+	bankDTO "pickrewardapi/internal/domain/bank/dto"
+	cardDTO "pickrewardapi/internal/domain/card/dto"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	catalogDomain "pickrewardapi/internal/domain/catalog/domain"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	channelDomain "pickrewardapi/internal/domain/channel/domain"
+	channelDTO "pickrewardapi/internal/domain/channel/dto"
+	channelLabelDTO "pickrewardapi/internal/domain/channel_label/dto"
+	legacyDTO "pickrewardapi/internal/domain/legacy/dto"
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+// The legacy rows have no dates worth keeping, the catalog import sets them.
+
+// MapBank maps a legacy bank, which has no status. The status of the stored
+// bank is kept, a new bank is active.
+func MapBank(b *legacyDTO.BankDTO, existing *bankDTO.BankDTO) *bankDTO.BankDTO {
+
+	status := commonM.Active
+	if existing != nil {
+		status = existing.BankStatus
+	}
+
+	return &bankDTO.BankDTO{
+		ID:         b.ID,
+		Name:       b.Name,
+		Order:      b.Order,
+		BankStatus: status,
+	}
+}
+
+func MapCard(c *legacyDTO.CardDTO) *cardDTO.CardDTO {
+
+	descriptions := c.Descriptions
+	if descriptions == nil {
+		descriptions = []string{}
+	}
+
+	return &cardDTO.CardDTO{
+		ID:           c.ID,
+		Name:         c.Name,
+		Descriptions: descriptions,
+		LinkURL:      c.LinkURL,
+		BankID:       c.BankID,
+		Order:        c.Order,
+		CardStatus:   commonM.Status(c.CardStatus),
+	}
+}
+
+func MapChannel(c *legacyDTO.ChannelDTO) *channelDTO.ChannelDTO {
+
+	labels := c.ChannelLabels
+	if labels == nil {
+		labels = []int32{}
+	}
+
+	return &channelDTO.ChannelDTO{
+		ID:            c.ID,
+		Name:          c.Name,
+		LinkURL:       c.LinkURL,
+		ChannelType:   c.ChannelType,
+		ChannelLabels: labels,
+		Order:         c.Order,
+		ChannelStatus: commonM.Status(c.ChannelStatus),
+	}
+}
+
+func MapChannelLabel(l *legacyDTO.ChannelLabelDTO) *channelLabelDTO.ChannelLabelDTO {
+	return &channelLabelDTO.ChannelLabelDTO{
+		Label: l.Label,
+		Name:  l.Name,
+		Show:  l.Show,
+	}
+}
+
+// MapReward maps a legacy reward, which has neither a rule nor a status. The
+// rule and status of the stored reward are kept. A new reward, or a stored
+// one without a rule, gets PlaceholderRule and is inactive until an admin
+// sets its rule.
+func MapReward(r *legacyDTO.RewardDTO, existing *rewardDTO.RewardDTO) *rewardDTO.RewardDTO {
+
+	description := r.Description
+	if len(description) == 0 {
+		description = []byte("null")
+	}
+
+	reward := &rewardDTO.RewardDTO{
+		ID:           r.ID,
+		CardID:       r.CardID,
+		Name:         r.Name,
+		Description:  description,
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		Currency:     r.Currency,
+		RewardType:   r.RewardType,
+		Order:        r.Order,
+		Rule:         PlaceholderRule(),
+		RewardStatus: commonM.Inactive,
+	}
+
+	if existing != nil && existing.Rule != nil {
+		reward.Rule = existing.Rule
+		reward.RewardStatus = existing.RewardStatus
+	}
+
+	return reward
+}
+
+// PlaceholderRule is the rule of a migrated reward the legacy data has no
+// rule for. It only passes Validate, the reward stays inactive so the rule is
+// never computed.
+func PlaceholderRule() *rewardDTO.RewardRuleDTO {
+	return &rewardDTO.RewardRuleDTO{
+		CalculateType: rewardDTO.Percentage,
+		Percentage:    1,
+		PeriodType:    rewardDTO.MonthPeriod,
+		ChannelIDs:    []string{},
+		ChannelLabels: []int32{},
+		PayIDs:        []string{},
+		Constraints:   []*commonM.Constraint{},
+	}
+}
+
+// Filter leaves out the entities the catalog import would reject, along with
+// the entities referring to a left out one, so one bad legacy row does not
+// stop the migration.
+func Filter(catalog *catalogDTO.CatalogDTO) (*catalogDTO.CatalogDTO, []*legacyDTO.SkippedDTO) {
+
+	kept := &catalogDTO.CatalogDTO{}
+	skipped := []*legacyDTO.SkippedDTO{}
+
+	skip := func(kind, ID, name string, reason error) {
+		skipped = append(skipped, &legacyDTO.SkippedDTO{Kind: kind, ID: ID, Name: name, Reason: reason.Error()})
+	}
+
+	labels := map[int32]bool{}
+	for _, l := range catalog.ChannelLabels {
+		if err := l.Validate(); err != nil {
+			skip(catalogDomain.CHANNEL_LABEL, fmt.Sprint(l.Label), l.Name, err)
+			continue
+		}
+		labels[l.Label] = true
+		kept.ChannelLabels = append(kept.ChannelLabels, l)
+	}
+
+	bankIDs := map[string]bool{}
+	for _, b := range catalog.Banks {
+		if err := b.Validate(); err != nil {
+			skip(catalogDomain.BANK, b.ID, b.Name, err)
+			continue
+		}
+		bankIDs[b.ID] = true
+		kept.Banks = append(kept.Banks, b)
+	}
+
+	cardIDs := map[string]bool{}
+	for _, c := range catalog.Cards {
+		if err := c.Validate(); err != nil {
+			skip(catalogDomain.CARD, c.ID, c.Name, err)
+			continue
+		}
+		if !bankIDs[c.BankID] {
+			skip(catalogDomain.CARD, c.ID, c.Name, fmt.Errorf("bank %s is not migrated", c.BankID))
+			continue
+		}
+		cardIDs[c.ID] = true
+		kept.Cards = append(kept.Cards, c)
+	}
+
+	for _, c := range catalog.Channels {
+		if err := validateChannel(c, labels); err != nil {
+			skip(catalogDomain.CHANNEL, c.ID, c.Name, err)
+			continue
+		}
+		kept.Channels = append(kept.Channels, c)
+	}
+
+	for _, r := range catalog.Rewards {
+		if err := r.Validate(); err != nil {
+			skip(catalogDomain.REWARD, r.ID, r.Name, err)
+			continue
+		}
+		if !cardIDs[r.CardID] {
+			skip(catalogDomain.REWARD, r.ID, r.Name, fmt.Errorf("card %s is not migrated", r.CardID))
+			continue
+		}
+		kept.Rewards = append(kept.Rewards, r)
+	}
+
+	return kept, skipped
+}
+
+func validateChannel(c *channelDTO.ChannelDTO, labels map[int32]bool) error {
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if channelDomain.GetChannelType(channelDomain.ChannelTypeEnum(c.ChannelType)) == nil {
+		return fmt.Errorf("Cannot find channel type %d", c.ChannelType)
+	}
+
+	for _, l := range c.ChannelLabels {
+		if !labels[l] {
+			return fmt.Errorf("channel label %d is not migrated", l)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"encoding/json"
+
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+)
+
+// The rows of the legacy database, NULL columns are read as zero values.
+
+type BankDTO struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Order int32  `json:"order"`
+}
+
+type CardDTO struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Descriptions []string `json:"descriptions"`
+	LinkURL      string   `json:"linkURL"`
+	BankID       string   `json:"bankID"`
+	Order        int32    `json:"order"`
+	CardStatus   int32    `json:"cardStatus"`
+}
+
+type ChannelDTO struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	LinkURL       string  `json:"linkURL"`
+	ChannelType   int32   `json:"channelType"`
+	ChannelLabels []int32 `json:"channelLabels"`
+	Order         int32   `json:"order"`
+	ChannelStatus int32   `json:"channelStatus"`
+}
+
+type ChannelLabelDTO struct {
+	Label int32  `json:"label"`
+	Name  string `json:"name"`
+	Show  int32  `json:"show"`
+}
+
+type RewardDTO struct {
+	ID          string          `json:"id"`
+	CardID      string          `json:"cardID"`
+	Name        string          `json:"name"`
+	Description json.RawMessage `json:"description"`
+	StartDate   int64           `json:"startDate"`
+	EndDate     int64           `json:"endDate"`
+	Currency    int32           `json:"currency"`
+	RewardType  int32           `json:"rewardType"`
+	Order       int32           `json:"order"`
+}
+
+// SkippedDTO is a legacy row left out of the migration and why.
+type SkippedDTO struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type MigrationResultDTO struct {
+	*catalogDTO.ImportResultDTO
+
+	Skipped []*SkippedDTO `json:"skipped"`
+}
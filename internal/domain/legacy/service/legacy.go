@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	baseCtx "pickrewardapi/base/ctx"
+
+	bankStore "pickrewardapi/internal/domain/bank/store"
+	rewardDTO "pickrewardapi/internal/domain/card_reward/dto"
+	rewardStore "pickrewardapi/internal/domain/card_reward/store"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	catalogService "pickrewardapi/internal/domain/catalog/service"
+	legacyDomain "pickrewardapi/internal/domain/legacy/domain"
+	legacyDTO "pickrewardapi/internal/domain/legacy/dto"
+	legacyStore "pickrewardapi/internal/domain/legacy/store"
+
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+type LegacyService interface {
+	// Migrate maps the legacy banks, cards, channels, channel labels and
+	// rewards to v2 and upserts them through the catalog import, so running
+	// it again only writes what changed in the legacy database. Rows v2
+	// cannot take are reported as skipped, nothing is written when dryRun is
+	// set.
+	Migrate(ctx context.Context, dryRun bool) (*legacyDTO.MigrationResultDTO, error)
+}
+
+type impl struct {
+	dig.In
+
+	legacyStore    legacyStore.LegacyStore
+	bankStore      bankStore.BankStore
+	rewardStore    rewardStore.RewardStore
+	catalogService catalogService.CatalogService
+}
+
+func New(
+	legacyStore legacyStore.LegacyStore,
+	bankStore bankStore.BankStore,
+	rewardStore rewardStore.RewardStore,
+	catalogService catalogService.CatalogService,
+) LegacyService {
+
+	impl := &impl{
+		legacyStore:    legacyStore,
+		bankStore:      bankStore,
+		rewardStore:    rewardStore,
+		catalogService: catalogService,
+	}
+
+	return impl
+}
+
+func (im *impl) Migrate(ctx context.Context, dryRun bool) (*legacyDTO.MigrationResultDTO, error) {
+	logPos := "[legacy.service][Migrate]"
+
+	ctx, span := tracing.Start(ctx, "legacy.service.Migrate")
+	defer span.End()
+
+	catalog, err := im.read(ctx)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("read failed: ", err)
+		return nil, err
+	}
+
+	catalog, skipped := legacyDomain.Filter(catalog)
+
+	result, err := im.catalogService.Import(ctx, catalog, dryRun)
+	if err != nil {
+		baseCtx.FromContext(ctx).WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("catalogService.Import failed: ", err)
+		return nil, err
+	}
+
+	return &legacyDTO.MigrationResultDTO{
+		ImportResultDTO: result,
+		Skipped:         skipped,
+	}, nil
+}
+
+// read maps the legacy rows to a catalog, keeping the fields only v2 has
+// from the stored banks and rewards.
+func (im *impl) read(ctx context.Context) (*catalogDTO.CatalogDTO, error) {
+
+	catalog := &catalogDTO.CatalogDTO{}
+
+	labels, err := im.legacyStore.GetChannelLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range labels {
+		catalog.ChannelLabels = append(catalog.ChannelLabels, legacyDomain.MapChannelLabel(l))
+	}
+
+	banks, err := im.legacyStore.GetBanks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range banks {
+		existing, err := im.bankStore.GetBankByID(ctx, b.ID)
+		if err != nil && !errors.Is(err, bankStore.ErrBankNotFound) {
+			return nil, err
+		}
+		catalog.Banks = append(catalog.Banks, legacyDomain.MapBank(b, existing))
+	}
+
+	cards, err := im.legacyStore.GetCards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cards {
+		catalog.Cards = append(catalog.Cards, legacyDomain.MapCard(c))
+	}
+
+	channels, err := im.legacyStore.GetChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range channels {
+		catalog.Channels = append(catalog.Channels, legacyDomain.MapChannel(c))
+	}
+
+	rewards, err := im.legacyStore.GetRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := im.rewardStore.GetAllRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storedByID := map[string]*rewardDTO.RewardDTO{}
+	for _, r := range stored {
+		storedByID[r.ID] = r
+	}
+
+	for _, r := range rewards {
+		catalog.Rewards = append(catalog.Rewards, legacyDomain.MapReward(r, storedByID[r.ID]))
+	}
+
+	return catalog, nil
+}
@@ -0,0 +1,314 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/dig"
+
+	legacyDTO "pickrewardapi/internal/domain/legacy/dto"
+
+	psql "pickrewardapi/internal/pkg/postgres"
+	"pickrewardapi/internal/pkg/tracing"
+)
+
+// LegacyStore reads the legacy database of the Migration pool, it never
+// writes to it.
+type LegacyStore interface {
+	GetBanks(ctx context.Context) ([]*legacyDTO.BankDTO, error)
+	GetCards(ctx context.Context) ([]*legacyDTO.CardDTO, error)
+	GetChannels(ctx context.Context) ([]*legacyDTO.ChannelDTO, error)
+	GetChannelLabels(ctx context.Context) ([]*legacyDTO.ChannelLabelDTO, error)
+	GetRewards(ctx context.Context) ([]*legacyDTO.RewardDTO, error)
+}
+
+type impl struct {
+	dig.In
+
+	migration *pgx.ConnPool
+}
+
+func New(sql *psql.Psql) LegacyStore {
+	logPos := "[legacy.store][New]"
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Info("init legacy store")
+
+	return &impl{
+		migration: sql.Migration,
+	}
+}
+
+// The legacy tables, NULL columns are read as zero values:
+//
+//	bank (id, name, image, order)
+//	card (id, name, descriptions, image, create_date, update_date, link_url, bank_id, order, card_status)
+//	channel (id, name, link_url, channel_type, create_date, update_date, channel_labels, order, channel_status)
+//	channel_label (label, name, show)
+//	card_reward (id, card_id, name, description, create_date, update_date, start_date, end_date, currency, reward_type, order)
+const (
+	BANK          = "bank"
+	CARD          = "card"
+	CHANNEL       = "channel"
+	CHANNEL_LABEL = "channel_label"
+	CARD_REWARD   = "card_reward"
+)
+
+var SELECT_BANKS_STAT = fmt.Sprintf(
+	"SELECT \"id\", COALESCE(\"name\", ''), COALESCE(\"order\", 0) "+
+		" FROM %s ORDER BY \"id\" ",
+	BANK,
+)
+
+func (im *impl) GetBanks(ctx context.Context) ([]*legacyDTO.BankDTO, error) {
+	logPos := "[legacy.store][GetBanks]"
+
+	ctx, span := tracing.StartQuery(ctx, "legacy.store.GetBanks", "SELECT_BANKS_STAT")
+	defer span.End()
+
+	bankDTOs := []*legacyDTO.BankDTO{}
+
+	rows, err := im.migration.QueryEx(ctx, SELECT_BANKS_STAT, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		b := &legacyDTO.BankDTO{}
+		if err := rows.Scan(&b.ID, &b.Name, &b.Order); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		bankDTOs = append(bankDTOs, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rows.Err failed: ", err)
+		return nil, err
+	}
+
+	return bankDTOs, nil
+}
+
+var SELECT_CARDS_STAT = fmt.Sprintf(
+	"SELECT \"id\", COALESCE(\"name\", ''), COALESCE(\"descriptions\", '[]'::json), "+
+		" COALESCE(\"link_url\", ''), COALESCE(\"bank_id\", ''), COALESCE(\"order\", 0), COALESCE(\"card_status\", 0) "+
+		" FROM %s ORDER BY \"id\" ",
+	CARD,
+)
+
+func (im *impl) GetCards(ctx context.Context) ([]*legacyDTO.CardDTO, error) {
+	logPos := "[legacy.store][GetCards]"
+
+	ctx, span := tracing.StartQuery(ctx, "legacy.store.GetCards", "SELECT_CARDS_STAT")
+	defer span.End()
+
+	cardDTOs := []*legacyDTO.CardDTO{}
+
+	rows, err := im.migration.QueryEx(ctx, SELECT_CARDS_STAT, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := &legacyDTO.CardDTO{}
+		selector := []interface{}{
+			&c.ID,
+			&c.Name,
+			&c.Descriptions,
+			&c.LinkURL,
+			&c.BankID,
+			&c.Order,
+			&c.CardStatus,
+		}
+
+		if err := rows.Scan(selector...); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		cardDTOs = append(cardDTOs, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rows.Err failed: ", err)
+		return nil, err
+	}
+
+	return cardDTOs, nil
+}
+
+var SELECT_CHANNELS_STAT = fmt.Sprintf(
+	"SELECT \"id\", COALESCE(\"name\", ''), COALESCE(\"link_url\", ''), COALESCE(\"channel_type\", 0), "+
+		" COALESCE(\"channel_labels\", '[]'::json), COALESCE(\"order\", 0), COALESCE(\"channel_status\", 0) "+
+		" FROM %s ORDER BY \"id\" ",
+	CHANNEL,
+)
+
+func (im *impl) GetChannels(ctx context.Context) ([]*legacyDTO.ChannelDTO, error) {
+	logPos := "[legacy.store][GetChannels]"
+
+	ctx, span := tracing.StartQuery(ctx, "legacy.store.GetChannels", "SELECT_CHANNELS_STAT")
+	defer span.End()
+
+	channelDTOs := []*legacyDTO.ChannelDTO{}
+
+	rows, err := im.migration.QueryEx(ctx, SELECT_CHANNELS_STAT, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := &legacyDTO.ChannelDTO{}
+		selector := []interface{}{
+			&c.ID,
+			&c.Name,
+			&c.LinkURL,
+			&c.ChannelType,
+			&c.ChannelLabels,
+			&c.Order,
+			&c.ChannelStatus,
+		}
+
+		if err := rows.Scan(selector...); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		channelDTOs = append(channelDTOs, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rows.Err failed: ", err)
+		return nil, err
+	}
+
+	return channelDTOs, nil
+}
+
+var SELECT_CHANNEL_LABELS_STAT = fmt.Sprintf(
+	"SELECT \"label\", COALESCE(\"name\", ''), COALESCE(\"show\", 0) "+
+		" FROM %s ORDER BY \"label\" ",
+	CHANNEL_LABEL,
+)
+
+func (im *impl) GetChannelLabels(ctx context.Context) ([]*legacyDTO.ChannelLabelDTO, error) {
+	logPos := "[legacy.store][GetChannelLabels]"
+
+	ctx, span := tracing.StartQuery(ctx, "legacy.store.GetChannelLabels", "SELECT_CHANNEL_LABELS_STAT")
+	defer span.End()
+
+	channelLabelDTOs := []*legacyDTO.ChannelLabelDTO{}
+
+	rows, err := im.migration.QueryEx(ctx, SELECT_CHANNEL_LABELS_STAT, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		l := &legacyDTO.ChannelLabelDTO{}
+		if err := rows.Scan(&l.Label, &l.Name, &l.Show); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		channelLabelDTOs = append(channelLabelDTOs, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rows.Err failed: ", err)
+		return nil, err
+	}
+
+	return channelLabelDTOs, nil
+}
+
+var SELECT_REWARDS_STAT = fmt.Sprintf(
+	"SELECT \"id\", COALESCE(\"card_id\", ''), COALESCE(\"name\", ''), COALESCE(\"description\", 'null'::json), "+
+		" COALESCE(\"start_date\", 0), COALESCE(\"end_date\", 0), COALESCE(\"currency\", 0), "+
+		" COALESCE(\"reward_type\", 0), COALESCE(\"order\", 0) "+
+		" FROM %s ORDER BY \"id\" ",
+	CARD_REWARD,
+)
+
+func (im *impl) GetRewards(ctx context.Context) ([]*legacyDTO.RewardDTO, error) {
+	logPos := "[legacy.store][GetRewards]"
+
+	ctx, span := tracing.StartQuery(ctx, "legacy.store.GetRewards", "SELECT_REWARDS_STAT")
+	defer span.End()
+
+	rewardDTOs := []*legacyDTO.RewardDTO{}
+
+	rows, err := im.migration.QueryEx(ctx, SELECT_REWARDS_STAT, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("psql.Query failed: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := &legacyDTO.RewardDTO{}
+		selector := []interface{}{
+			&r.ID,
+			&r.CardID,
+			&r.Name,
+			&r.Description,
+			&r.StartDate,
+			&r.EndDate,
+			&r.Currency,
+			&r.RewardType,
+			&r.Order,
+		}
+
+		if err := rows.Scan(selector...); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("rows.Scan failed: ", err)
+			return nil, err
+		}
+		rewardDTOs = append(rewardDTOs, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("rows.Err failed: ", err)
+		return nil, err
+	}
+
+	return rewardDTOs, nil
+}
@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+
+	"pickrewardapi/base/ctx"
+
+	config "pickrewardapi/internal/pkg/config"
+	"pickrewardapi/internal/pkg/metrics"
+)
+
+// KEY_PREFIX prefixes the Redis keys of the cache.
+const KEY_PREFIX = "pickrewardapi:cache:"
+
+// redisTimeout bounds the Redis calls so an unreachable Redis only delays a
+// read a little before it falls back to the store.
+const redisTimeout = 500 * time.Millisecond
+
+const (
+	TIER_LRU   = "lru"
+	TIER_REDIS = "redis"
+)
+
+// Cache is a read-through cache of two tiers, an in-process LRU and an
+// optional Redis shared by the instances. Values are kept encoded so callers
+// never share a cached value.
+//
+// Invalidate clears the LRU of this instance and the Redis tier, the LRU of
+// the other instances keeps an entry until its TTL.
+type Cache struct {
+	lru   *expirable.LRU[string, []byte]
+	redis *redis.Client
+	cfg   config.Cache
+}
+
+func New(cfg *config.Config) *Cache {
+	logPos := "[cache][New]"
+
+	c := &Cache{
+		cfg: cfg.Cache,
+	}
+
+	if cfg.Cache.LRUSize > 0 {
+		c.lru = expirable.NewLRU[string, []byte](cfg.Cache.LRUSize, nil, cfg.Cache.LRUTTL)
+	}
+
+	if cfg.Cache.RedisAddr != "" {
+		c.redis = redis.NewClient(&redis.Options{
+			Addr:     cfg.Cache.RedisAddr,
+			Password: cfg.Cache.RedisPassword,
+			DB:       cfg.Cache.RedisDB,
+
+			DialTimeout:  redisTimeout,
+			ReadTimeout:  redisTimeout,
+			WriteTimeout: redisTimeout,
+		})
+	}
+
+	log.WithFields(log.Fields{
+		"pos":        logPos,
+		"lru.size":   cfg.Cache.LRUSize,
+		"redis.addr": cfg.Cache.RedisAddr,
+	}).Info("init cache")
+
+	return c
+}
+
+// Key joins the query name and its parameters, e.g. Key("bank", "all", 1) is
+// bank:all:1. The first part is the namespace Invalidate clears.
+func Key(namespace string, parts ...interface{}) string {
+	key := namespace
+	for _, p := range parts {
+		key += ":" + fmt.Sprint(p)
+	}
+	return key
+}
+
+// Fetch returns the cached value of key, or loads it, caches it and returns
+// it. A failing cache tier is skipped, the errors of load are not cached.
+func Fetch[T any](c context.Context, cache *Cache, key string, load func(c context.Context) (T, error)) (T, error) {
+	logPos := "[cache][Fetch]"
+
+	if cache == nil || (cache.lru == nil && cache.redis == nil) {
+		return load(c)
+	}
+
+	if data, ok := cache.get(c, key); ok {
+		var value T
+		err := json.Unmarshal(data, &value)
+		if err == nil {
+			return value, nil
+		}
+
+		log.WithFields(log.Fields{
+			"pos": logPos,
+			"key": key,
+		}).Warn("json.Unmarshal failed: ", err)
+	}
+
+	value, err := load(c)
+	if err != nil {
+		return value, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+			"key": key,
+		}).Warn("json.Marshal failed: ", err)
+		return value, nil
+	}
+
+	cache.set(c, key, data)
+	return value, nil
+}
+
+func (cache *Cache) get(c context.Context, key string) ([]byte, bool) {
+	logPos := "[cache][get]"
+
+	if cache.lru != nil {
+		if data, ok := cache.lru.Get(key); ok {
+			metrics.ObserveCache(TIER_LRU, "hit")
+			return data, true
+		}
+		metrics.ObserveCache(TIER_LRU, "miss")
+	}
+
+	if cache.redis == nil {
+		return nil, false
+	}
+
+	ctx.FromContext(c).IncrRedisCount("cache", 1)
+	data, err := cache.redis.Get(c, KEY_PREFIX+key).Bytes()
+	switch {
+	case err == redis.Nil:
+		metrics.ObserveCache(TIER_REDIS, "miss")
+		return nil, false
+	case err != nil:
+		metrics.ObserveCache(TIER_REDIS, "error")
+		log.WithFields(log.Fields{
+			"pos": logPos,
+			"key": key,
+		}).Warn("redis.Get failed: ", err)
+		return nil, false
+	}
+
+	metrics.ObserveCache(TIER_REDIS, "hit")
+	if cache.lru != nil {
+		cache.lru.Add(key, data)
+	}
+	return data, true
+}
+
+func (cache *Cache) set(c context.Context, key string, data []byte) {
+	logPos := "[cache][set]"
+
+	if cache.lru != nil {
+		cache.lru.Add(key, data)
+	}
+
+	if cache.redis == nil {
+		return
+	}
+
+	ctx.FromContext(c).IncrRedisCount("cache", 1)
+	if err := cache.redis.Set(c, KEY_PREFIX+key, data, cache.cfg.RedisTTL).Err(); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+			"key": key,
+		}).Warn("redis.Set failed: ", err)
+	}
+}
+
+// Invalidate drops the entries of the namespaces from both tiers.
+func (cache *Cache) Invalidate(c context.Context, namespaces ...string) {
+	logPos := "[cache][Invalidate]"
+
+	if cache == nil {
+		return
+	}
+
+	for _, ns := range namespaces {
+		prefix := ns + ":"
+
+		if cache.lru != nil {
+			for _, key := range cache.lru.Keys() {
+				if strings.HasPrefix(key, prefix) {
+					cache.lru.Remove(key)
+				}
+			}
+		}
+
+		if cache.redis == nil {
+			continue
+		}
+
+		iter := cache.redis.Scan(c, 0, KEY_PREFIX+prefix+"*", 100).Iterator()
+		keys := []string{}
+		for iter.Next(c) {
+			keys = append(keys, iter.Val())
+		}
+		ctx.FromContext(c).IncrRedisCount("cache", 1)
+
+		if err := iter.Err(); err != nil {
+			log.WithFields(log.Fields{
+				"pos":       logPos,
+				"namespace": ns,
+			}).Error("redis.Scan failed: ", err)
+			continue
+		}
+
+		if len(keys) == 0 {
+			continue
+		}
+
+		ctx.FromContext(c).IncrRedisCount("cache", 1)
+		if err := cache.redis.Del(c, keys...).Err(); err != nil {
+			log.WithFields(log.Fields{
+				"pos":       logPos,
+				"namespace": ns,
+			}).Error("redis.Del failed: ", err)
+		}
+	}
+}
+
+// Close closes the Redis client.
+func (cache *Cache) Close() error {
+	if cache.redis == nil {
+		return nil
+	}
+	return cache.redis.Close()
+}
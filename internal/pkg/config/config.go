@@ -0,0 +1,357 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Config is the configuration of the servers and commands, loaded by Load.
+type Config struct {
+	Env string
+
+	App       App
+	Primary   Postgres
+	Migration Postgres
+	Trace     Trace
+	Cache     Cache
+}
+
+type App struct {
+	ServerPort int
+
+	UseTLS      bool
+	TLSCertPath string
+	TLSKeyPath  string
+
+	// AdminToken enables the admin server when it is not empty.
+	AdminToken string
+	// TesterTokens let the calls sending one read the pilot items, the
+	// preview is disabled when it is empty.
+	TesterTokens []string
+
+	ShutdownTimeout time.Duration
+
+	// The listeners below are disabled when their port is 0.
+	MetricsPort int
+	HTTPPort    int
+	GrpcWebPort int
+
+	HTTPTimeout           time.Duration
+	RPCTimeout            time.Duration
+	GrpcReflection        bool
+	GrpcWebAllowedOrigins []string
+
+	// DemoFixture is the catalog file loaded into in-memory stores instead
+	// of Postgres, the demo mode is off when it is empty.
+	DemoFixture string
+}
+
+type Postgres struct {
+	Host           string
+	Port           uint16
+	Database       string
+	User           string
+	Password       string
+	MaxConnections int
+}
+
+type Trace struct {
+	Exporter     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+}
+
+// Cache configures the read-through cache of the catalog stores, a tier is
+// disabled when its size or address is empty.
+type Cache struct {
+	LRUSize int
+	LRUTTL  time.Duration
+
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+	RedisTTL      time.Duration
+}
+
+// IsDemo reports whether the stores are in memory, loaded from
+// App.DemoFixture.
+func (c *Config) IsDemo() bool {
+	return c.App.DemoFixture != ""
+}
+
+// IsProd reports whether the config is of the prod environment.
+func (c *Config) IsProd() bool {
+	return c.Env == "prod"
+}
+
+// ENV_FILE_KEY names the env file to load, DEFAULT_ENV_FILE by default.
+const (
+	ENV_FILE_KEY     = "ENV_FILE"
+	DEFAULT_ENV_FILE = ".env.dev"
+)
+
+type key struct {
+	name     string
+	def      string
+	required bool
+	usage    string
+}
+
+var keys = []key{
+	{name: "ENV", def: "dev", usage: "environment, dev or prod"},
+
+	{name: "APP_SERVER_PORT", def: "50055", usage: "port of the gRPC server"},
+	{name: "APP_USE_TLS", def: "false", usage: "serve gRPC and gRPC-Web over TLS"},
+	{name: "APP_TLS_CERT_PATH", usage: "TLS certificate, required with APP_USE_TLS"},
+	{name: "APP_TLS_KEY_PATH", usage: "TLS key, required with APP_USE_TLS"},
+	{name: "APP_ADMIN_TOKEN", usage: "token of the admin server, disabled if empty"},
+	{name: "APP_TESTER_TOKENS", usage: "comma separated tokens of the testers previewing pilot items, disabled if empty"},
+	{name: "APP_SHUTDOWN_TIMEOUT", def: "30", usage: "seconds to wait for in-flight calls on shutdown"},
+	{name: "APP_METRICS_PORT", def: "0", usage: "port of the metrics listener, disabled if 0"},
+	{name: "APP_HTTP_PORT", def: "0", usage: "port of the REST gateway, disabled if 0"},
+	{name: "APP_HTTP_TIMEOUT", def: "10", usage: "seconds of a REST gateway request"},
+	{name: "APP_RPC_TIMEOUT", def: "10", usage: "seconds of a gRPC call sent without a deadline, no limit if 0"},
+	{name: "APP_GRPC_REFLECTION", def: "false", usage: "register the gRPC reflection service"},
+	{name: "APP_GRPC_WEB_PORT", def: "0", usage: "port of the gRPC-Web listener, disabled if 0"},
+	{name: "APP_GRPC_WEB_ALLOWED_ORIGINS", usage: "comma separated CORS origins of gRPC-Web, * allows all"},
+	{name: "APP_DEMO_FIXTURE", usage: "catalog file served from memory without Postgres, disabled if empty"},
+
+	{name: "POSTGRES_HOST", required: true, usage: "host of the primary database"},
+	{name: "POSTGRES_PORT", def: "5432", usage: "port of the primary database"},
+	{name: "POSTGRES_DB", required: true, usage: "name of the primary database"},
+	{name: "POSTGRES_USER", required: true, usage: "user of the primary database"},
+	{name: "POSTGRES_PASSWORD", required: true, usage: "password of the primary database"},
+	{name: "POSTGRES_MAX_CONNECTIONS", def: "5", usage: "pool size of the primary database"},
+
+	{name: "POSTGRES_MIGRATION_HOST", required: true, usage: "host of the legacy database"},
+	{name: "POSTGRES_MIGRATION_PORT", def: "5432", usage: "port of the legacy database"},
+	{name: "POSTGRES_MIGRATION_DB", required: true, usage: "name of the legacy database"},
+	{name: "POSTGRES_MIGRATION_USER", required: true, usage: "user of the legacy database"},
+	{name: "POSTGRES_MIGRATION_PASSWORD", required: true, usage: "password of the legacy database"},
+	{name: "POSTGRES_MIGRATION_MAX_CONNECTIONS", def: "5", usage: "pool size of the legacy database"},
+
+	{name: "APP_TRACE_EXPORTER", def: "none", usage: "span exporter, none, stdout or otlp"},
+	{name: "APP_TRACE_OTLP_ENDPOINT", usage: "host:port of the OTLP collector"},
+	{name: "APP_TRACE_OTLP_INSECURE", def: "false", usage: "export to the OTLP collector without TLS"},
+
+	{name: "CACHE_LRU_SIZE", def: "1000", usage: "entries of the in-process cache, disabled if 0"},
+	{name: "CACHE_LRU_TTL", def: "60", usage: "seconds an in-process cache entry lives"},
+	{name: "CACHE_REDIS_ADDR", usage: "host:port of the Redis cache, disabled if empty"},
+	{name: "CACHE_REDIS_PASSWORD", usage: "password of the Redis cache"},
+	{name: "CACHE_REDIS_DB", def: "0", usage: "database of the Redis cache"},
+	{name: "CACHE_REDIS_TTL", def: "600", usage: "seconds a Redis cache entry lives"},
+}
+
+// Load loads the config from the env file, the environment and the flags in
+// args, a later source overrides an earlier one. The env file is named by
+// --env-file or ENV_FILE and is optional if neither is set. Every missing or
+// invalid key is listed in the returned error.
+func Load(args []string) (*Config, error) {
+	logPos := "[config][Load]"
+
+	v := viper.New()
+
+	flags := pflag.NewFlagSet("pickrewardapi", pflag.ContinueOnError)
+	envFile := flags.String("env-file", "", "env file to load, "+ENV_FILE_KEY+" by default")
+
+	for _, k := range keys {
+		v.SetDefault(k.name, k.def)
+
+		flags.String(flagName(k.name), "", k.usage+" ("+k.name+")")
+		if err := v.BindPFlag(k.name, flags.Lookup(flagName(k.name))); err != nil {
+			return nil, err
+		}
+
+		if err := v.BindEnv(k.name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	file := *envFile
+	if file == "" {
+		file = os.Getenv(ENV_FILE_KEY)
+	}
+
+	if file == "" {
+		if _, err := os.Stat(DEFAULT_ENV_FILE); err == nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Info("Cannot find ENV_FILE env, alternative get " + DEFAULT_ENV_FILE)
+
+			file = DEFAULT_ENV_FILE
+		}
+	}
+
+	if file != "" {
+		v.SetConfigFile(file)
+		v.SetConfigType("env")
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("read %s: %w", file, err)
+		}
+	}
+
+	l := &loader{v: v}
+	c := l.load()
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"pos":      logPos,
+		"env":      c.Env,
+		"env.file": file,
+	}).Info("Loaded config")
+
+	return c, nil
+}
+
+func flagName(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", "-"))
+}
+
+// loader reads typed values and collects the missing and invalid keys.
+type loader struct {
+	v *viper.Viper
+
+	missing []string
+	invalid []error
+}
+
+func (l *loader) load() *Config {
+	// The demo mode runs without Postgres.
+	demo := l.str("APP_DEMO_FIXTURE") != ""
+
+	for _, k := range keys {
+		if demo && strings.HasPrefix(k.name, "POSTGRES_") {
+			continue
+		}
+		if k.required && l.str(k.name) == "" {
+			l.missing = append(l.missing, k.name)
+		}
+	}
+
+	c := &Config{
+		Env: l.str("ENV"),
+		App: App{
+			ServerPort:            l.int("APP_SERVER_PORT"),
+			UseTLS:                l.bool("APP_USE_TLS"),
+			TLSCertPath:           l.str("APP_TLS_CERT_PATH"),
+			TLSKeyPath:            l.str("APP_TLS_KEY_PATH"),
+			AdminToken:            l.str("APP_ADMIN_TOKEN"),
+			TesterTokens:          l.strs("APP_TESTER_TOKENS"),
+			ShutdownTimeout:       l.seconds("APP_SHUTDOWN_TIMEOUT"),
+			MetricsPort:           l.int("APP_METRICS_PORT"),
+			HTTPPort:              l.int("APP_HTTP_PORT"),
+			GrpcWebPort:           l.int("APP_GRPC_WEB_PORT"),
+			HTTPTimeout:           l.seconds("APP_HTTP_TIMEOUT"),
+			RPCTimeout:            l.seconds("APP_RPC_TIMEOUT"),
+			GrpcReflection:        l.bool("APP_GRPC_REFLECTION"),
+			GrpcWebAllowedOrigins: l.strs("APP_GRPC_WEB_ALLOWED_ORIGINS"),
+			DemoFixture:           l.str("APP_DEMO_FIXTURE"),
+		},
+		Primary:   l.postgres("POSTGRES_"),
+		Migration: l.postgres("POSTGRES_MIGRATION_"),
+		Trace: Trace{
+			Exporter:     strings.ToLower(l.str("APP_TRACE_EXPORTER")),
+			OTLPEndpoint: l.str("APP_TRACE_OTLP_ENDPOINT"),
+			OTLPInsecure: l.bool("APP_TRACE_OTLP_INSECURE"),
+		},
+		Cache: Cache{
+			LRUSize:       l.int("CACHE_LRU_SIZE"),
+			LRUTTL:        l.seconds("CACHE_LRU_TTL"),
+			RedisAddr:     l.str("CACHE_REDIS_ADDR"),
+			RedisPassword: l.str("CACHE_REDIS_PASSWORD"),
+			RedisDB:       l.int("CACHE_REDIS_DB"),
+			RedisTTL:      l.seconds("CACHE_REDIS_TTL"),
+		},
+	}
+
+	if c.App.UseTLS {
+		if c.App.TLSCertPath == "" {
+			l.missing = append(l.missing, "APP_TLS_CERT_PATH")
+		}
+		if c.App.TLSKeyPath == "" {
+			l.missing = append(l.missing, "APP_TLS_KEY_PATH")
+		}
+	}
+
+	return c
+}
+
+func (l *loader) postgres(prefix string) Postgres {
+	return Postgres{
+		Host:           l.str(prefix + "HOST"),
+		Port:           uint16(l.int(prefix + "PORT")),
+		Database:       l.str(prefix + "DB"),
+		User:           l.str(prefix + "USER"),
+		Password:       l.str(prefix + "PASSWORD"),
+		MaxConnections: l.int(prefix + "MAX_CONNECTIONS"),
+	}
+}
+
+func (l *loader) err() error {
+	errs := []error{}
+	if len(l.missing) > 0 {
+		errs = append(errs, fmt.Errorf("missing config: %s", strings.Join(l.missing, ", ")))
+	}
+	return errors.Join(append(errs, l.invalid...)...)
+}
+
+func (l *loader) str(key string) string {
+	return strings.TrimSpace(l.v.GetString(key))
+}
+
+func (l *loader) int(key string) int {
+	value := l.str(key)
+	if value == "" {
+		return 0
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		l.invalid = append(l.invalid, fmt.Errorf("invalid %s: %s", key, value))
+		return 0
+	}
+	return n
+}
+
+func (l *loader) bool(key string) bool {
+	value := l.str(key)
+	if value == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		l.invalid = append(l.invalid, fmt.Errorf("invalid %s: %s", key, value))
+		return false
+	}
+	return b
+}
+
+func (l *loader) seconds(key string) time.Duration {
+	return time.Duration(l.int(key)) * time.Second
+}
+
+// strs returns the comma separated values of key.
+func (l *loader) strs(key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(l.str(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
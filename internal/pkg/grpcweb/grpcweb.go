@@ -0,0 +1,110 @@
+package grpcweb
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	improbable "github.com/improbable-eng/grpc-web/go/grpcweb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"pickrewardapi/internal/pkg/interceptor"
+)
+
+// ALLOW_ALL_ORIGINS allows cross-origin calls from every origin.
+const ALLOW_ALL_ORIGINS = "*"
+
+// allowedHeaders are the request headers a browser may send.
+var allowedHeaders = []string{
+	"content-type",
+	"x-grpc-web",
+	"x-user-agent",
+	"grpc-timeout",
+	interceptor.REQUEST_ID_KEY,
+	interceptor.ERROR_MODE_KEY,
+	interceptor.TESTER_TOKEN_KEY,
+	"traceparent",
+	"tracestate",
+}
+
+// Server serves the methods of the given gRPC services to browsers over
+// gRPC-Web, the calls go through the interceptors of the gRPC server.
+type Server struct {
+	server *http.Server
+}
+
+// New wraps s for gRPC-Web, only the methods of services are served and
+// allowedOrigins are the origins allowed by CORS.
+func New(s *grpc.Server, services []string, allowedOrigins []string) *Server {
+	logPos := "[grpcweb][New]"
+
+	endpoints := map[string]bool{}
+	endpointList := []string{}
+	info := s.GetServiceInfo()
+	for _, service := range services {
+		for _, method := range info[service].Methods {
+			endpoint := "/" + service + "/" + method.Name
+			endpoints[endpoint] = true
+			endpointList = append(endpointList, endpoint)
+		}
+	}
+
+	origins := map[string]bool{}
+	for _, origin := range allowedOrigins {
+		origins[origin] = true
+	}
+
+	wrapped := improbable.WrapServer(s,
+		improbable.WithOriginFunc(func(origin string) bool {
+			return origins[ALLOW_ALL_ORIGINS] || origins[origin]
+		}),
+		improbable.WithEndpointsFunc(func() []string {
+			return endpointList
+		}),
+		improbable.WithAllowedRequestHeaders(allowedHeaders),
+	)
+
+	log.WithFields(log.Fields{
+		"pos":             logPos,
+		"services":        services,
+		"allowed.origins": allowedOrigins,
+	}).Info("init grpc-web")
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !endpoints[r.URL.Path] {
+			http.NotFound(w, r)
+			return
+		}
+		wrapped.ServeHTTP(w, r)
+	})
+
+	return &Server{
+		server: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// ListenAndServe serves gRPC-Web on addr until Shutdown is called, over TLS
+// if certPath and keyPath are set.
+func (s *Server) ListenAndServe(addr, certPath, keyPath string) error {
+	s.server.Addr = addr
+
+	var err error
+	if certPath != "" && keyPath != "" {
+		err = s.server.ListenAndServeTLS(certPath, keyPath)
+	} else {
+		err = s.server.ListenAndServe()
+	}
+
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(c context.Context) error {
+	return s.server.Shutdown(c)
+}
@@ -0,0 +1,90 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	psql "pickrewardapi/internal/pkg/postgres"
+)
+
+// Checker serves the standard gRPC health service, the server ("") and
+// every registered service are SERVING while both database pools answer.
+type Checker struct {
+	server   *health.Server
+	sql      *psql.Psql
+	services []string
+}
+
+const pingTimeout = 3 * time.Second
+
+func New(sql *psql.Psql) *Checker {
+	return &Checker{
+		server: health.NewServer(),
+		sql:    sql,
+	}
+}
+
+// Register adds the health service to s, it is called after the other
+// services are registered so their names get a status.
+func (c *Checker) Register(s *grpc.Server) {
+
+	c.services = []string{""}
+	for name := range s.GetServiceInfo() {
+		c.services = append(c.services, name)
+	}
+
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	healthpb.RegisterHealthServer(s, c.server)
+}
+
+// Run checks the databases at every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown sets every service NOT_SERVING and ignores later checks.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) check(ctx context.Context) {
+	logPos := "[health][check]"
+
+	pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	if err := c.sql.Ping(pingCtx); err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Error("sql.Ping failed: ", err)
+
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+
+	c.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, s := range c.services {
+		c.server.SetServingStatus(s, status)
+	}
+}
@@ -0,0 +1,373 @@
+package interceptor
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"pickrewardapi/base/ctx"
+	"pickrewardapi/base/goroutine"
+
+	"pickrewardapi/internal/pkg/metrics"
+	"pickrewardapi/internal/pkg/tracing"
+
+	"pickrewardapi/internal/shared/common/errs"
+	"pickrewardapi/internal/shared/common/preview"
+	commonS "pickrewardapi/internal/shared/common/service"
+)
+
+// REQUEST_ID_KEY is the metadata key of the request ID, a request ID sent by
+// the client is kept and the ID is returned in the response header.
+const REQUEST_ID_KEY = "x-request-id"
+
+// ERROR_MODE_KEY is the metadata key a client sets to ERROR_MODE_REPLY to get
+// failures as the reply with its Reply.Error body and no gRPC error, as
+// before the status errors. Other clients get gRPC status errors.
+const (
+	ERROR_MODE_KEY   = "x-error-mode"
+	ERROR_MODE_REPLY = "reply"
+)
+
+// TESTER_TOKEN_KEY is the metadata key of the tester token, the calls sending
+// a valid one read the pilot items along with the active ones.
+const TESTER_TOKEN_KEY = "x-tester-token"
+
+// ServerOptions chains the interceptors of every RPC, the request ID first so
+// the others log with it, the tracing after the error status so the span
+// gets the code of the typed error and the recovery last so a panic is
+// logged as an Internal status. Unary calls sent without a deadline get
+// rpcTimeout, streams are not bounded. Unary calls sending one of
+// testerTokens preview the pilot items, the preview check runs after the
+// logging so a denied token is logged.
+func ServerOptions(rpcTimeout time.Duration, testerTokens []string) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			UnaryRequestID(),
+			UnaryTimeout(rpcTimeout),
+			UnaryErrorStatus(),
+			UnaryTracing(),
+			UnaryLogging(),
+			UnaryMetrics(),
+			UnaryPreview(testerTokens),
+			UnaryRecovery(),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamRequestID(),
+			StreamErrorStatus(),
+			StreamTracing(),
+			StreamLogging(),
+			StreamMetrics(),
+			StreamRecovery(),
+		),
+	}
+}
+
+func UnaryRequestID() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withRequestID(c, info.FullMethod), req)
+	}
+}
+
+func StreamRequestID() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          withRequestID(ss.Context(), info.FullMethod),
+		})
+	}
+}
+
+// UnaryTimeout sets a deadline of d on calls sent without one, the stores stop
+// their queries once it passes. A deadline sent by the client is kept, a d of
+// 0 sets none.
+func UnaryTimeout(d time.Duration) grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := c.Deadline(); ok || d <= 0 {
+			return handler(c, req)
+		}
+
+		cc, cancel := ctx.WithTimeout(ctx.FromContext(c), d)
+		defer cancel()
+
+		return handler(cc, req)
+	}
+}
+
+// UnaryPreview marks the calls sending one of tokens as TESTER_TOKEN_KEY as
+// previews, see preview.Statuses. A call sending another token is denied
+// rather than served without the pilot items, calls sending none are served
+// as before.
+func UnaryPreview(tokens []string) grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(c)
+		if !ok {
+			return handler(c, req)
+		}
+
+		sent := md.Get(TESTER_TOKEN_KEY)
+		if len(sent) == 0 {
+			return handler(c, req)
+		}
+
+		if !preview.IsTester(sent[0], tokens) {
+			return nil, status.Error(codes.PermissionDenied, "invalid tester token")
+		}
+
+		return handler(preview.With(c), req)
+	}
+}
+
+// UnaryErrorStatus turns the typed errors returned by handlers next to their
+// failure replies into gRPC status errors with details, or drops them for
+// clients asking for ERROR_MODE_REPLY so they read Reply.Error as before.
+func UnaryErrorStatus() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(c, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		if !isNil(resp) && wantsReply(c) {
+			return resp, nil
+		}
+
+		return nil, errs.Status(err)
+	}
+}
+
+func StreamErrorStatus() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return errs.Status(handler(srv, ss))
+	}
+}
+
+// UnaryTracing starts the server span of the call as a child of the trace
+// context in the incoming metadata.
+func UnaryTracing() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		c, span := startSpan(c, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(c, req)
+
+		endSpan(span, err)
+		return resp, err
+	}
+}
+
+func StreamTracing() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		c, span := startSpan(ss.Context(), info.FullMethod)
+		defer span.End()
+
+		err := handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          c,
+		})
+
+		endSpan(span, err)
+		return err
+	}
+}
+
+func UnaryLogging() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(c, req)
+
+		logCall(c, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func StreamLogging() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		logCall(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func UnaryMetrics() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(c, req)
+
+		metrics.ObserveRPC(info.FullMethod, errs.GRPCCode(err), time.Since(start))
+		return resp, err
+	}
+}
+
+func StreamMetrics() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		metrics.ObserveRPC(info.FullMethod, errs.GRPCCode(err), time.Since(start))
+		return err
+	}
+}
+
+func UnaryRecovery() grpc.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(c, info.FullMethod, r)
+			}
+		}()
+
+		return handler(c, req)
+	}
+}
+
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// withRequestID returns a CTX of c logging with the request ID and method.
+func withRequestID(c context.Context, method string) ctx.CTX {
+
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(c); ok {
+		if ids := md.Get(REQUEST_ID_KEY); len(ids) > 0 {
+			requestID = ids[0]
+		}
+	}
+
+	if requestID == "" {
+		requestID = commonS.GenUUID()
+	}
+
+	grpc.SetHeader(c, metadata.Pairs(REQUEST_ID_KEY, requestID))
+
+	return ctx.New(c, log.WithFields(log.Fields{
+		"requestID": requestID,
+		"method":    method,
+	}))
+}
+
+// startSpan returns c with the server span of method, method is the full
+// method name /package.Service/Method.
+func startSpan(c context.Context, method string) (context.Context, trace.Span) {
+
+	service, name := "", strings.TrimPrefix(method, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		service, name = name[:i], name[i+1:]
+	}
+
+	return tracing.Start(tracing.Extract(c), method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCServiceKey.String(service),
+			semconv.RPCMethodKey.String(name),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+
+	code := errs.GRPCCode(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(code)))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, code.String())
+	}
+}
+
+func logCall(c context.Context, method string, start time.Time, err error) {
+
+	code := errs.GRPCCode(err)
+	fields := log.Fields{
+		"pos":     "[interceptor][logCall]",
+		"method":  method,
+		"latency": time.Since(start).String(),
+		"code":    code.String(),
+	}
+
+	if sc := trace.SpanContextFromContext(c); sc.HasTraceID() {
+		fields["traceID"] = sc.TraceID().String()
+	}
+
+	cc := ctx.FromContext(c)
+	if n, ok := cc.LoadRedisCacheCount(); ok && n > 0 {
+		fields["redisCacheCount"] = n
+	}
+
+	logger := cc.WithFields(fields)
+
+	switch code {
+	case codes.OK:
+		logger.Info("Call")
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		logger.Error("Call failed: ", err)
+	default:
+		logger.Warn("Call failed: ", err)
+	}
+}
+
+func recovered(c context.Context, method string, r interface{}) error {
+
+	ctx.FromContext(c).WithFields(log.Fields{
+		"pos":    "[interceptor][recovered]",
+		"method": method,
+		"err":    r,
+		"stack":  string(goroutine.Stack(3)),
+	}).Error("panic")
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func wantsReply(c context.Context) bool {
+	md, ok := metadata.FromIncomingContext(c)
+	if !ok {
+		return false
+	}
+
+	modes := md.Get(ERROR_MODE_KEY)
+	return len(modes) > 0 && modes[0] == ERROR_MODE_REPLY
+}
+
+func isNil(resp interface{}) bool {
+	if resp == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(resp)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
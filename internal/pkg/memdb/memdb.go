@@ -0,0 +1,100 @@
+package memdb
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Table is a map of rows guarded by a lock. Rows are copied in and out so a
+// caller never shares a stored row, as with rows scanned from a database.
+type Table[K comparable, V any] struct {
+	mu   sync.RWMutex
+	rows map[K]*V
+}
+
+func NewTable[K comparable, V any]() *Table[K, V] {
+	return &Table[K, V]{
+		rows: map[K]*V{},
+	}
+}
+
+// Put inserts the row or replaces the row of key.
+func (t *Table[K, V]) Put(key K, row *V) error {
+	row, err := clone(row)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rows[key] = row
+	return nil
+}
+
+// Get returns the row of key, nil if there is none.
+func (t *Table[K, V]) Get(key K) (*V, error) {
+	t.mu.RLock()
+	row, ok := t.rows[key]
+	t.mu.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	return clone(row)
+}
+
+// Select returns the rows where returns true, in no particular order.
+func (t *Table[K, V]) Select(where func(row *V) bool) ([]*V, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rows := []*V{}
+	for _, row := range t.rows {
+		if !where(row) {
+			continue
+		}
+
+		row, err := clone(row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// All is the where of Select selecting every row.
+func All[V any](row *V) bool {
+	return true
+}
+
+// Limit returns at most limit rows of rows from offset, as LIMIT and OFFSET
+// do, and the count of rows.
+func Limit[V any](rows []*V, offset, limit int32) ([]*V, int32) {
+	total := int32(len(rows))
+	if offset >= total {
+		return []*V{}, total
+	}
+
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return rows[offset:end], total
+}
+
+func clone[V any](row *V) (*V, error) {
+	b, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(V)
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"pickrewardapi/base/goroutine"
+)
+
+type connPool interface {
+	Stat() pgx.ConnPoolStat
+}
+
+// connPoolCollector reads ConnPool.Stat() of every pool at scrape time.
+type connPoolCollector struct {
+	pools map[string]connPool
+
+	maxConnections       *prometheus.Desc
+	currentConnections   *prometheus.Desc
+	availableConnections *prometheus.Desc
+	acquiredConnections  *prometheus.Desc
+}
+
+func newConnPoolCollector(pools map[string]connPool) *connPoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(NAMESPACE, "pgx_pool", name), help, []string{"pool"}, nil)
+	}
+
+	return &connPoolCollector{
+		pools:                pools,
+		maxConnections:       desc("max_connections", "Max simultaneous connections of the pool."),
+		currentConnections:   desc("current_connections", "Live connections of the pool."),
+		availableConnections: desc("available_connections", "Unused live connections of the pool."),
+		acquiredConnections:  desc("acquired_connections", "Connections checked out of the pool."),
+	}
+}
+
+func (c *connPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxConnections
+	ch <- c.currentConnections
+	ch <- c.availableConnections
+	ch <- c.acquiredConnections
+}
+
+func (c *connPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, pool := range c.pools {
+		stat := pool.Stat()
+
+		ch <- prometheus.MustNewConstMetric(c.maxConnections, prometheus.GaugeValue, float64(stat.MaxConnections), name)
+		ch <- prometheus.MustNewConstMetric(c.currentConnections, prometheus.GaugeValue, float64(stat.CurrentConnections), name)
+		ch <- prometheus.MustNewConstMetric(c.availableConnections, prometheus.GaugeValue, float64(stat.AvailableConnections), name)
+		ch <- prometheus.MustNewConstMetric(c.acquiredConnections, prometheus.GaugeValue, float64(stat.CheckedOutConnections()), name)
+	}
+}
+
+// goroutinePoolCollector reads the sizes of every open goroutine.Pool at
+// scrape time.
+type goroutinePoolCollector struct {
+	workers       *prometheus.Desc
+	activeWorkers *prometheus.Desc
+	maxWorkers    *prometheus.Desc
+	queueSize     *prometheus.Desc
+	queueCapacity *prometheus.Desc
+}
+
+func newGoroutinePoolCollector() *goroutinePoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(NAMESPACE, "goroutine_pool", name), help, []string{"pool"}, nil)
+	}
+
+	return &goroutinePoolCollector{
+		workers:       desc("workers", "Spawned workers of the pool."),
+		activeWorkers: desc("active_workers", "Workers running a task."),
+		maxWorkers:    desc("max_workers", "Max workers of the pool."),
+		queueSize:     desc("queue_size", "Tasks waiting in the queue."),
+		queueCapacity: desc("queue_capacity", "Capacity of the queue."),
+	}
+}
+
+func (c *goroutinePoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.workers
+	ch <- c.activeWorkers
+	ch <- c.maxWorkers
+	ch <- c.queueSize
+	ch <- c.queueCapacity
+}
+
+func (c *goroutinePoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, pool := range goroutine.Pools() {
+		name := pool.GetName()
+
+		ch <- prometheus.MustNewConstMetric(c.workers, prometheus.GaugeValue, float64(pool.GetSize()), name)
+		ch <- prometheus.MustNewConstMetric(c.activeWorkers, prometheus.GaugeValue, float64(pool.GetActiveWorker()), name)
+		ch <- prometheus.MustNewConstMetric(c.maxWorkers, prometheus.GaugeValue, float64(pool.GetMaxSize()), name)
+		ch <- prometheus.MustNewConstMetric(c.queueSize, prometheus.GaugeValue, float64(pool.GetQueueSize()), name)
+		ch <- prometheus.MustNewConstMetric(c.queueCapacity, prometheus.GaugeValue, float64(pool.GetQueueCap()), name)
+	}
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	psql "pickrewardapi/internal/pkg/postgres"
+)
+
+const NAMESPACE = "pickrewardapi"
+
+// METRICS_PATH is the path the metrics are exposed on.
+const METRICS_PATH = "/metrics"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: NAMESPACE,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of RPCs handled by method and code.",
+	}, []string{"method", "code"})
+
+	rpcLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: NAMESPACE,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of RPCs by method and code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: NAMESPACE,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Number of cache lookups by tier and result, hit, miss or error.",
+	}, []string{"tier", "result"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcLatency,
+		cacheRequests,
+		newGoroutinePoolCollector(),
+	)
+}
+
+// ObserveRPC counts a handled RPC and its latency.
+func ObserveRPC(method string, code codes.Code, latency time.Duration) {
+	rpcRequests.WithLabelValues(method, code.String()).Inc()
+	rpcLatency.WithLabelValues(method, code.String()).Observe(latency.Seconds())
+}
+
+// ObserveCache counts a lookup of a cache tier.
+func ObserveCache(tier, result string) {
+	cacheRequests.WithLabelValues(tier, result).Inc()
+}
+
+// Server exposes the metrics over HTTP.
+type Server struct {
+	server *http.Server
+}
+
+// New returns the metrics server, sql is nil in the demo mode and has no
+// pool metrics then.
+func New(sql *psql.Psql) *Server {
+	logPos := "[metrics][New]"
+
+	if sql != nil {
+		registry.MustRegister(newConnPoolCollector(map[string]connPool{
+			"primary":   sql.Primary,
+			"migration": sql.Migration,
+		}))
+	}
+
+	log.WithFields(log.Fields{
+		"pos": logPos,
+	}).Info("init metrics")
+
+	mux := http.NewServeMux()
+	mux.Handle(METRICS_PATH, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	return &Server{
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// ListenAndServe serves the metrics on addr until Shutdown is called.
+func (s *Server) ListenAndServe(addr string) error {
+	s.server.Addr = addr
+
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx"
+
+	"pickrewardapi/internal/shared/common/errs"
+)
+
+func init() {
+	errs.RegisterClassifier(classify)
+}
+
+// classify tells the kind of pgx errors, a lost or exhausted database is
+// Unavailable and a unique violation is a Conflict.
+func classify(err error) (errs.Kind, bool) {
+
+	var pgErr pgx.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "23505":
+			return errs.Conflict, true
+		case strings.HasPrefix(pgErr.Code, "08"), strings.HasPrefix(pgErr.Code, "57P"):
+			return errs.Unavailable, true
+		}
+		return errs.Internal, false
+	}
+
+	switch {
+	case errors.Is(err, pgx.ErrDeadConn),
+		errors.Is(err, pgx.ErrConnBusy),
+		errors.Is(err, pgx.ErrAcquireTimeout),
+		errors.Is(err, pgx.ErrClosedPool):
+		return errs.Unavailable, true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return errs.Unavailable, true
+	}
+
+	return errs.Internal, false
+}
@@ -1,34 +1,27 @@
 package postgres
 
 import (
-	"os"
-	"strconv"
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx"
 	"github.com/sirupsen/logrus"
-)
-
-type Config struct {
-	Host     string
-	Database string
-	Port     uint32
-	User     string
-	Password string
 
-	MaxConnections uint64
-	AfterTimeout   uint64
-}
+	config "pickrewardapi/internal/pkg/config"
+)
 
+// Psql holds the pools of the primary and legacy databases. It is nil in the
+// demo mode, where Ping and Close do nothing.
 type Psql struct {
 	Primary   *pgx.ConnPool
 	Migration *pgx.ConnPool
 }
 
-func NewPsql() *Psql {
+func NewPsql(cfg *config.Config) *Psql {
 
-	primarySql := NewPrimarySql()
-	migrationSql := NewMigrationSql()
+	primarySql := NewPrimarySql(cfg)
+	migrationSql := NewMigrationSql(cfg)
 
 	return &Psql{
 		Primary:   primarySql,
@@ -37,98 +30,67 @@ func NewPsql() *Psql {
 
 }
 
-func NewPrimarySql() *pgx.ConnPool {
-	username := os.Getenv("POSTGRES_USER")
-	if username == "" {
-		username = "postgres"
-	}
-	password := os.Getenv("POSTGRES_PASSWORD")
-	if password == "" {
-		password = "z20339"
-	}
-
-	host := os.Getenv("POSTGRES_HOST")
-
-	if host == "" {
-		host = "127.0.0.1"
-	}
-	port := os.Getenv("POSTGRES_PORT")
-	if port == "" {
-		port = "5432"
+// Ping checks both pools reach their database.
+func (p *Psql) Ping(ctx context.Context) error {
+	if p == nil {
+		return nil
 	}
 
-	db := os.Getenv("POSTGRES_DB")
-	if db == "" {
-		db = "pickreward_v2"
+	if err := ping(ctx, p.Primary); err != nil {
+		return fmt.Errorf("primary: %w", err)
 	}
 
-	portInt, err := strconv.ParseUint(port, 10, 64)
-
-	logrus.Info("[psql] host:", host)
-
-	pgxConfig := pgx.ConnConfig{
-		Host:     host, //host.docker.internal
-		Database: db,
-		Port:     uint16(portInt),
-		User:     username,
-		Password: password,
+	if err := ping(ctx, p.Migration); err != nil {
+		return fmt.Errorf("migration: %w", err)
 	}
 
-	conn, err := pgx.NewConnPool(pgx.ConnPoolConfig{
-		ConnConfig:     pgxConfig,
-		MaxConnections: 5,
-		AfterConnect:   nil,
-		AcquireTimeout: time.Duration(30) * time.Second,
-	})
+	return nil
+}
 
+func ping(ctx context.Context, pool *pgx.ConnPool) error {
+	conn, err := pool.AcquireEx(ctx)
 	if err != nil {
-		logrus.Fatal(err)
-		panic(-1)
+		return err
 	}
+	defer pool.Release(conn)
 
-	return conn
+	return conn.Ping(ctx)
 }
 
-func NewMigrationSql() *pgx.ConnPool {
-	username := os.Getenv("POSTGRES_MIGRATION_USER")
-	if username == "" {
-		username = "postgres"
-	}
-	password := os.Getenv("POSTGRES_MIGRATION_PASSWORD")
-	if password == "" {
-		password = "z20339"
+// Close waits for the acquired connections to be released and closes both
+// pools.
+func (p *Psql) Close() {
+	if p == nil {
+		return
 	}
 
-	host := os.Getenv("POSTGRES_MIGRATION_HOST")
+	p.Primary.Close()
+	p.Migration.Close()
+}
 
-	if host == "" {
-		host = "127.0.0.1"
-	}
-	port := os.Getenv("POSTGRES_MIGRATION_PORT")
-	if port == "" {
-		port = "5432"
-	}
+func NewPrimarySql(cfg *config.Config) *pgx.ConnPool {
+	return newConnPool(cfg.Primary)
+}
 
-	db := os.Getenv("POSTGRES_MIGRATION_DB")
-	if db == "" {
-		db = "pickreward"
-	}
+func NewMigrationSql(cfg *config.Config) *pgx.ConnPool {
+	return newConnPool(cfg.Migration)
+}
 
-	portInt, err := strconv.ParseUint(port, 10, 64)
+func newConnPool(c config.Postgres) *pgx.ConnPool {
 
-	logrus.Info("[psql] host:", host)
+	logrus.Info("[psql] host:", c.Host)
 
 	pgxConfig := pgx.ConnConfig{
-		Host:     host, //host.docker.internal
-		Database: db,
-		Port:     uint16(portInt),
-		User:     username,
-		Password: password,
+		Host:     c.Host, //host.docker.internal
+		Database: c.Database,
+		Port:     c.Port,
+		User:     c.User,
+		Password: c.Password,
 	}
 
 	conn, err := pgx.NewConnPool(pgx.ConnPoolConfig{
 		ConnConfig:     pgxConfig,
-		MaxConnections: 5,
+		MaxConnections: c.MaxConnections,
 		AfterConnect:   nil,
 		AcquireTimeout: time.Duration(30) * time.Second,
 	})
@@ -0,0 +1,4 @@
+-- 0001 adopts the tables of the former script/postgres files, so going down
+-- only drops the columns it added to card_reward and keeps the catalog data.
+ALTER TABLE card_reward DROP COLUMN IF EXISTS "reward_status";
+ALTER TABLE card_reward DROP COLUMN IF EXISTS "rule";
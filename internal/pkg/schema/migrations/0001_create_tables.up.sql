@@ -0,0 +1,67 @@
+-- Tables queried by the stores. Databases created from the former
+-- script/postgres files already have them, the ALTER TABLE statements add the
+-- columns those scripts were missing. The stores scan the added columns into
+-- plain values, so they are NOT NULL with a default filling the former rows:
+-- the former banks had no status and were all shown, the former rewards have
+-- no rule and stay inactive (0) until they get one.
+CREATE TABLE IF NOT EXISTS bank (
+    "id" VARCHAR(36) PRIMARY KEY,
+    "name" VARCHAR(100),
+    "order" INT,
+    "bank_status" INT,
+    "create_date" BIGINT,
+    "update_date" BIGINT
+);
+
+ALTER TABLE bank ADD COLUMN IF NOT EXISTS "bank_status" INT NOT NULL DEFAULT 2;
+ALTER TABLE bank ADD COLUMN IF NOT EXISTS "create_date" BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bank ADD COLUMN IF NOT EXISTS "update_date" BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS card (
+    "id" VARCHAR(36) PRIMARY KEY,
+    "name" VARCHAR(100),
+    "descriptions" JSON,
+    "link_url" TEXT,
+    "bank_id" VARCHAR(36),
+    "order" INT,
+    "card_status" INT,
+    "create_date" BIGINT,
+    "update_date" BIGINT
+);
+
+CREATE TABLE IF NOT EXISTS card_reward (
+    "id" VARCHAR(36) PRIMARY KEY,
+    "card_id" VARCHAR(36),
+    "name" VARCHAR(100),
+    "description" JSON,
+    "start_date" BIGINT,
+    "end_date" BIGINT,
+    "currency" INT,
+    "reward_type" INT,
+    "order" INT,
+    "rule" JSON,
+    "reward_status" INT,
+    "create_date" BIGINT,
+    "update_date" BIGINT
+);
+
+ALTER TABLE card_reward ADD COLUMN IF NOT EXISTS "rule" JSON;
+ALTER TABLE card_reward ADD COLUMN IF NOT EXISTS "reward_status" INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS channel (
+    "id" VARCHAR(36) PRIMARY KEY,
+    "name" VARCHAR(100),
+    "link_url" TEXT,
+    "channel_type" INT,
+    "channel_labels" JSON,
+    "order" INT,
+    "channel_status" INT,
+    "create_date" BIGINT,
+    "update_date" BIGINT
+);
+
+CREATE TABLE IF NOT EXISTS channel_label (
+    "label" INT PRIMARY KEY,
+    "name" TEXT,
+    "show" INT
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS channel_channel_type_idx;
+DROP INDEX IF EXISTS card_reward_card_id_idx;
+DROP INDEX IF EXISTS card_bank_id_idx;
@@ -0,0 +1,4 @@
+-- Indexes of the columns the stores filter on.
+CREATE INDEX IF NOT EXISTS card_bank_id_idx ON card ("bank_id");
+CREATE INDEX IF NOT EXISTS card_reward_card_id_idx ON card_reward ("card_id");
+CREATE INDEX IF NOT EXISTS channel_channel_type_idx ON channel ("channel_type");
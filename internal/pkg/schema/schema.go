@@ -0,0 +1,325 @@
+package schema
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:embed migrations/*.sql
+var files embed.FS
+
+// VERSION_TABLE keeps a row per applied migration.
+const VERSION_TABLE = "schema_version"
+
+// LOCK_ID is the advisory lock held while migrating so concurrent runs wait
+// for each other.
+const LOCK_ID = 7203118
+
+var CREATE_VERSION_TABLE_STAT = fmt.Sprintf(
+	"CREATE TABLE IF NOT EXISTS %s ( "+
+		" \"version\" BIGINT PRIMARY KEY, "+
+		" \"name\" TEXT NOT NULL, "+
+		" \"applied_at\" BIGINT NOT NULL "+
+		" ) ", VERSION_TABLE)
+
+var APPLIED_VERSIONS_STAT = fmt.Sprintf(
+	"SELECT \"version\", \"name\", \"applied_at\" FROM %s ORDER BY \"version\" ", VERSION_TABLE)
+
+var INSERT_VERSION_STAT = fmt.Sprintf(
+	"INSERT INTO %s (\"version\", \"name\", \"applied_at\") VALUES ($1, $2, $3) ", VERSION_TABLE)
+
+var DELETE_VERSION_STAT = fmt.Sprintf(
+	"DELETE FROM %s WHERE \"version\" = $1 ", VERSION_TABLE)
+
+// fileName matches 0001_create_tables.up.sql.
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a version of the schema, Up moves the previous version to it
+// and Down moves it back.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration with the time it was applied, AppliedAt is zero when
+// it is pending.
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt int64
+}
+
+func (s *Status) Applied() bool {
+	return s.AppliedAt != 0
+}
+
+// Migrations returns the embedded migrations ordered by version.
+func Migrations() ([]*Migration, error) {
+
+	entries, err := fs.ReadDir(files, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+
+		match := fileName.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration %s: name is not NNNN_name.up.sql or NNNN_name.down.sql", e.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", e.Name(), err)
+		}
+
+		body, err := files.ReadFile("migrations/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d: names %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s: up and down files are both required", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator applies the embedded migrations to a database and records them in
+// VERSION_TABLE, every migration runs in its own transaction.
+type Migrator struct {
+	pool       *pgx.ConnPool
+	migrations []*Migration
+}
+
+func New(pool *pgx.ConnPool) (*Migrator, error) {
+
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		pool:       pool,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies the pending migrations in order, at most steps of them when
+// steps is positive, and returns the applied ones.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]*Migration, error) {
+	logPos := "[schema][Up]"
+
+	var done []*Migration
+	err := m.locked(ctx, func(conn *pgx.Conn, applied map[int64]*Status) error {
+
+		for _, mg := range m.migrations {
+			if steps > 0 && len(done) == steps {
+				break
+			}
+
+			if _, ok := applied[mg.Version]; ok {
+				continue
+			}
+
+			if err := run(ctx, conn, mg.Up, INSERT_VERSION_STAT, mg.Version, mg.Name, time.Now().Unix()); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mg.Version, mg.Name, err)
+			}
+
+			log.WithFields(log.Fields{
+				"pos":     logPos,
+				"version": mg.Version,
+				"name":    mg.Name,
+			}).Info("applied")
+
+			done = append(done, mg)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down reverts the latest applied migrations, steps of them or all of them
+// when steps is not positive, and returns the reverted ones.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	logPos := "[schema][Down]"
+
+	byVersion := map[int64]*Migration{}
+	for _, mg := range m.migrations {
+		byVersion[mg.Version] = mg
+	}
+
+	var done []*Migration
+	err := m.locked(ctx, func(conn *pgx.Conn, applied map[int64]*Status) error {
+
+		versions := make([]int64, 0, len(applied))
+		for v := range applied {
+			versions = append(versions, v)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		for _, v := range versions {
+			if steps > 0 && len(done) == steps {
+				break
+			}
+
+			mg, ok := byVersion[v]
+			if !ok {
+				return fmt.Errorf("migration %d_%s is applied but unknown to this build", v, applied[v].Name)
+			}
+
+			if err := run(ctx, conn, mg.Down, DELETE_VERSION_STAT, mg.Version); err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", mg.Version, mg.Name, err)
+			}
+
+			log.WithFields(log.Fields{
+				"pos":     logPos,
+				"version": mg.Version,
+				"name":    mg.Name,
+			}).Info("reverted")
+
+			done = append(done, mg)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Status returns every embedded migration and the applied ones unknown to
+// this build, ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+
+	var statuses []*Status
+	err := m.locked(ctx, func(conn *pgx.Conn, applied map[int64]*Status) error {
+
+		for _, mg := range m.migrations {
+			s := &Status{Version: mg.Version, Name: mg.Name}
+			if a, ok := applied[mg.Version]; ok {
+				s.AppliedAt = a.AppliedAt
+				delete(applied, mg.Version)
+			}
+			statuses = append(statuses, s)
+		}
+
+		for _, a := range applied {
+			statuses = append(statuses, a)
+		}
+
+		return nil
+	})
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, err
+}
+
+// locked runs f on a connection holding LOCK_ID with the applied migrations,
+// the version table is created first.
+func (m *Migrator) locked(ctx context.Context, f func(conn *pgx.Conn, applied map[int64]*Status) error) error {
+
+	conn, err := m.pool.AcquireEx(ctx)
+	if err != nil {
+		return err
+	}
+	defer m.pool.Release(conn)
+
+	if _, err := conn.ExecEx(ctx, "SELECT pg_advisory_lock($1)", nil, LOCK_ID); err != nil {
+		return err
+	}
+	defer conn.Exec("SELECT pg_advisory_unlock($1)", LOCK_ID)
+
+	if _, err := conn.ExecEx(ctx, CREATE_VERSION_TABLE_STAT, nil); err != nil {
+		return err
+	}
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return f(conn, applied)
+}
+
+func appliedVersions(ctx context.Context, conn *pgx.Conn) (map[int64]*Status, error) {
+
+	rows, err := conn.QueryEx(ctx, APPLIED_VERSIONS_STAT, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]*Status{}
+	for rows.Next() {
+		s := &Status{}
+		if err := rows.Scan(&s.Version, &s.Name, &s.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied[s.Version] = s
+	}
+
+	return applied, rows.Err()
+}
+
+// run executes the statements of a migration and the version statement in a
+// transaction.
+func run(ctx context.Context, conn *pgx.Conn, statements string, versionStat string, args ...interface{}) error {
+
+	tx, err := conn.BeginEx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecEx(ctx, statements, nil); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecEx(ctx, versionStat, nil, args...); err != nil {
+		return err
+	}
+
+	return tx.CommitEx(ctx)
+}
@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataCarrier reads and writes the trace context in gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	values := metadata.MD(m).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Extract returns c with the remote span of the trace context in the incoming
+// metadata of c.
+func Extract(c context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(c)
+	if !ok {
+		return c
+	}
+
+	return otel.GetTextMapPropagator().Extract(c, metadataCarrier(md))
+}
+
+// Inject returns c with the trace context of its span in the outgoing
+// metadata.
+func Inject(c context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(c)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	otel.GetTextMapPropagator().Inject(c, metadataCarrier(md))
+	return metadata.NewOutgoingContext(c, md)
+}
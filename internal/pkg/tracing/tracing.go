@@ -0,0 +1,117 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	config "pickrewardapi/internal/pkg/config"
+)
+
+const SERVICE_NAME = "pickrewardapi"
+
+// Exporters selected by config.Trace.Exporter, spans are not recorded with
+// EXPORTER_NONE.
+const (
+	EXPORTER_NONE   = "none"
+	EXPORTER_STDOUT = "stdout"
+	EXPORTER_OTLP   = "otlp"
+)
+
+// STATEMENT_NAME_KEY tags a store span with the name of its SQL statement,
+// e.g. SELECT_CARDS_BY_KEYWORD_STAT.
+const STATEMENT_NAME_KEY = attribute.Key("db.statement.name")
+
+var tracer = otel.Tracer(SERVICE_NAME)
+
+// Init sets the global tracer provider of the exporter in cfg and the W3C
+// trace context propagator, the returned func flushes the pending spans.
+func Init(c context.Context, cfg config.Trace) (func(context.Context) error, error) {
+	logPos := "[tracing][Init]"
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporterName := cfg.Exporter
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case "", EXPORTER_NONE:
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Info("Without tracing, APP_TRACE_EXPORTER is empty")
+		return func(context.Context) error { return nil }, nil
+
+	case EXPORTER_STDOUT:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+
+	case EXPORTER_OTLP:
+		opts := []otlptracegrpc.Option{}
+		if cfg.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint))
+		}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(c, opts...)
+
+	default:
+		return nil, fmt.Errorf("unknown APP_TRACE_EXPORTER: %s", exporterName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(SERVICE_NAME),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.WithFields(log.Fields{
+		"pos":      logPos,
+		"exporter": exporterName,
+	}).Info("init tracing")
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name as a child of the span in c.
+func Start(c context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(c, name, opts...)
+}
+
+// StartQuery starts a span of a store method tagged with the names of the SQL
+// statements it runs.
+func StartQuery(c context.Context, name string, stats ...string) (context.Context, trace.Span) {
+	statAttr := STATEMENT_NAME_KEY.StringSlice(stats)
+	if len(stats) == 1 {
+		statAttr = STATEMENT_NAME_KEY.String(stats[0])
+	}
+
+	return tracer.Start(c, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, statAttr),
+	)
+}
@@ -0,0 +1,149 @@
+// Package errs defines the typed errors stores and services return, the kind
+// of an error decides its gRPC status code and its error code in replies.
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type Kind int32
+
+const (
+	Internal Kind = iota
+	InvalidArgument
+	NotFound
+	Conflict
+	Unavailable
+	DeadlineExceeded
+	Canceled
+)
+
+// Error codes of Reply.Error, 100 was the only code before the kinds and
+// stays the code of internal errors.
+const (
+	INTERNAL_CODE          int32 = 100
+	INVALID_ARGUMENT_CODE  int32 = 101
+	NOT_FOUND_CODE         int32 = 102
+	CONFLICT_CODE          int32 = 103
+	UNAVAILABLE_CODE       int32 = 104
+	DEADLINE_EXCEEDED_CODE int32 = 105
+	CANCELED_CODE          int32 = 106
+)
+
+type kindInfo struct {
+	Name string
+	Code int32
+}
+
+var kindMapper = map[Kind]*kindInfo{
+	Internal:         {Name: "INTERNAL", Code: INTERNAL_CODE},
+	InvalidArgument:  {Name: "INVALID_ARGUMENT", Code: INVALID_ARGUMENT_CODE},
+	NotFound:         {Name: "NOT_FOUND", Code: NOT_FOUND_CODE},
+	Conflict:         {Name: "CONFLICT", Code: CONFLICT_CODE},
+	Unavailable:      {Name: "UNAVAILABLE", Code: UNAVAILABLE_CODE},
+	DeadlineExceeded: {Name: "DEADLINE_EXCEEDED", Code: DEADLINE_EXCEEDED_CODE},
+	Canceled:         {Name: "CANCELED", Code: CANCELED_CODE},
+}
+
+func (k Kind) String() string {
+	if info, ok := kindMapper[k]; ok {
+		return info.Name
+	}
+	return kindMapper[Internal].Name
+}
+
+// Code returns the error code of the kind in Reply.Error.
+func (k Kind) Code() int32 {
+	if info, ok := kindMapper[k]; ok {
+		return info.Code
+	}
+	return INTERNAL_CODE
+}
+
+type Error struct {
+	Kind    Kind
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func New(kind Kind, message string) error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Wrap returns err as an error of the kind, nil stays nil.
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+func NewNotFound(message string) error {
+	return New(NotFound, message)
+}
+
+func NewInvalidArgument(format string, a ...interface{}) error {
+	return New(InvalidArgument, fmt.Sprintf(format, a...))
+}
+
+func NewConflict(message string) error {
+	return New(Conflict, message)
+}
+
+// Classifier tells the kind of an untyped error, such as a driver error, ok
+// is false when it does not know the error.
+type Classifier func(err error) (kind Kind, ok bool)
+
+var classifiers = []Classifier{}
+
+// RegisterClassifier adds a classifier used by KindOf, it is meant to be
+// called in init functions.
+func RegisterClassifier(c Classifier) {
+	classifiers = append(classifiers, c)
+}
+
+// KindOf returns the kind of the first typed error in err's chain, untyped
+// errors are the context errors or go through the registered classifiers and
+// default to Internal.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return Canceled
+	}
+
+	for _, c := range classifiers {
+		if kind, ok := c(err); ok {
+			return kind
+		}
+	}
+
+	return Internal
+}
+
+// Code returns the error code of err in Reply.Error.
+func Code(err error) int32 {
+	return KindOf(err).Code()
+}
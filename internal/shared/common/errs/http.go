@@ -0,0 +1,28 @@
+package errs
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+var httpStatuses = map[codes.Code]int{
+	codes.OK:               http.StatusOK,
+	codes.InvalidArgument:  http.StatusBadRequest,
+	codes.NotFound:         http.StatusNotFound,
+	codes.AlreadyExists:    http.StatusConflict,
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.DeadlineExceeded: http.StatusGatewayTimeout,
+	codes.Unavailable:      http.StatusServiceUnavailable,
+	// 499 is the status nginx logs for a client closing the request.
+	codes.Canceled: 499,
+}
+
+// HTTPStatus returns the HTTP status code of err by its gRPC status code.
+func HTTPStatus(err error) int {
+	if status, ok := httpStatuses[GRPCCode(err)]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,74 @@
+package errs
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ERROR_DOMAIN is the domain of the ErrorInfo details.
+const ERROR_DOMAIN = "pickrewardapi"
+
+var grpcCodes = map[Kind]codes.Code{
+	Internal:         codes.Internal,
+	InvalidArgument:  codes.InvalidArgument,
+	NotFound:         codes.NotFound,
+	Conflict:         codes.AlreadyExists,
+	Unavailable:      codes.Unavailable,
+	DeadlineExceeded: codes.DeadlineExceeded,
+	Canceled:         codes.Canceled,
+}
+
+// GRPCCode returns the gRPC status code of err, errors already carrying a
+// status keep their code.
+func GRPCCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+
+	if code, ok := grpcCodes[KindOf(err)]; ok {
+		return code
+	}
+	return codes.Internal
+}
+
+// Status returns err as a gRPC status error with an ErrorInfo detail holding
+// its kind and error code, errors already carrying a status are kept.
+func Status(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	kind := KindOf(err)
+
+	message := err.Error()
+	if kind == Internal {
+		// Internal errors may hold queries and driver messages.
+		message = "internal error"
+	}
+
+	s := status.New(GRPCCode(err), message)
+
+	withDetails, detailsErr := s.WithDetails(&errdetails.ErrorInfo{
+		Reason: kind.String(),
+		Domain: ERROR_DOMAIN,
+		Metadata: map[string]string{
+			"errorCode": strconv.Itoa(int(kind.Code())),
+		},
+	})
+	if detailsErr != nil {
+		return s.Err()
+	}
+
+	return withDetails.Err()
+}
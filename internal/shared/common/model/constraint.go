@@ -45,6 +45,7 @@ type Constraint struct {
 	ConstraintType ConstraintType `json:"constraintType"`
 	ConstraintName string         `json:"constraintName"`
 	WeekDays       []int32        `json:"weekDays"`
+	LimitCount     int32          `json:"limitCount"`
 }
 
 // Make the Attrs struct implement the driver.Valuer interface. This method
@@ -79,7 +80,7 @@ func GetConstraintType(constraintType int32) (*Constraint, error) {
 	constraint, ok := constraintTypeMapper[ConstraintType(constraintType)]
 
 	if !ok {
-		return nil, errors.New("Cannot find currency type")
+		return nil, errors.New("Cannot find constraint type")
 	}
 	return constraint, nil
 }
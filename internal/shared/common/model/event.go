@@ -9,6 +9,7 @@ type Event struct {
 	ChannelEvent *ChannelEvent `json:"channelEvent"`
 	PayEvent     *PayEvent     `json:"payEvent"`
 	CardEvent    *CardEvent    `json:"cardEvent"`
+	UserEvent    *UserEvent    `json:"userEvent"`
 }
 
 type CardEvent struct {
@@ -38,3 +39,10 @@ type ChannelIDEvent struct {
 	ChannelID     string         `json:"channelID"`
 	ChannelLabels map[int32]bool `json:"channelLabels"`
 }
+
+// UserEvent is what the user tells about themselves, a card or reward missing
+// from the maps is unknown.
+type UserEvent struct {
+	NewCustomerCardIDs  map[string]bool `json:"newCustomerCardIDs"`
+	RegisteredRewardIDs map[string]bool `json:"registeredRewardIDs"`
+}
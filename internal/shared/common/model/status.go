@@ -1,5 +1,7 @@
 package model
 
+import "errors"
+
 type Status int32
 
 var AllStatuses = []Status{Active, Pilot, Inactive}
@@ -9,3 +11,24 @@ const (
 	Pilot
 	Active
 )
+
+func GetStatus(status int32) (Status, error) {
+
+	for _, s := range AllStatuses {
+		if Status(status) == s {
+			return s, nil
+		}
+	}
+
+	return Inactive, errors.New("Cannot find status")
+}
+
+// Int32s returns statuses as the []int32 pgx encodes as an int4[], e.g. for
+// "status" = ANY($1).
+func Int32s(statuses []Status) []int32 {
+	values := make([]int32, 0, len(statuses))
+	for _, s := range statuses {
+		values = append(values, int32(s))
+	}
+	return values
+}
@@ -0,0 +1,150 @@
+// Package page slices the list RPCs into pages. A page token is opaque to
+// clients, it holds the offset of the next page and a fingerprint of the
+// list it was issued for so it cannot be replayed on another list.
+package page
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"pickrewardapi/internal/shared/common/errs"
+)
+
+const (
+	DEFAULT_SIZE int32 = 20
+	// MAX_SIZE bounds the size a client asks for, larger sizes get MAX_SIZE.
+	MAX_SIZE int32 = 100
+)
+
+// Request asks for Size items from the position of Token, the first page
+// when Token is empty. Offset moves the first page for lists that took an
+// offset before page tokens.
+type Request struct {
+	Size   int32
+	Token  string
+	Offset int32
+}
+
+// Info is the position of a page in its list, NextToken is empty on the last
+// page and Total counts the items of all pages.
+type Info struct {
+	NextToken string
+	Total     int32
+}
+
+type token struct {
+	Offset int32  `json:"o"`
+	List   uint32 `json:"l"`
+}
+
+// Cursor is the position of the page a Request asks for in its list, for
+// lists paged by the store with LIMIT and OFFSET.
+type Cursor struct {
+	Offset int32
+	Size   int32
+
+	list uint32
+}
+
+// Open returns the Cursor of req. list names the list with its parameters,
+// e.g. "cards.bank", bankID, a token issued for another list is an invalid
+// argument.
+func Open(req Request, list ...interface{}) (*Cursor, error) {
+
+	if req.Size < 0 {
+		return nil, errs.NewInvalidArgument("invalid page size: %d", req.Size)
+	}
+
+	size := req.Size
+	switch {
+	case size == 0:
+		size = DEFAULT_SIZE
+	case size > MAX_SIZE:
+		size = MAX_SIZE
+	}
+
+	fingerprint := fingerprint(list)
+
+	offset := req.Offset
+	if req.Token != "" {
+		t, err := decode(req.Token)
+		if err != nil || t.List != fingerprint {
+			return nil, errs.NewInvalidArgument("invalid page token")
+		}
+		offset = t.Offset
+	}
+	if offset < 0 {
+		return nil, errs.NewInvalidArgument("invalid page offset: %d", offset)
+	}
+
+	return &Cursor{
+		Offset: offset,
+		Size:   size,
+		list:   fingerprint,
+	}, nil
+}
+
+// Info returns the Info of the page at c in a list of total items.
+func (c *Cursor) Info(total int32) *Info {
+	info := &Info{
+		Total: total,
+	}
+
+	if end := c.Offset + c.Size; end < total {
+		info.NextToken = encode(&token{Offset: end, List: c.list})
+	}
+
+	return info
+}
+
+// Slice returns the page of items req asks for, for lists loaded whole, e.g.
+// from the cache. list names the list as of Open.
+func Slice[T any](items []T, req Request, list ...interface{}) ([]T, *Info, error) {
+
+	c, err := Open(req, list...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	total := int32(len(items))
+	info := c.Info(total)
+
+	if c.Offset >= total {
+		return []T{}, info, nil
+	}
+
+	end := c.Offset + c.Size
+	if end > total {
+		end = total
+	}
+
+	return items[c.Offset:end], info, nil
+}
+
+func fingerprint(list []interface{}) uint32 {
+	h := fnv.New32a()
+	for _, p := range list {
+		fmt.Fprintf(h, "%v\x00", p)
+	}
+	return h.Sum32()
+}
+
+func encode(t *token) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) (*token, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &token{}
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,95 @@
+package page
+
+import (
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+
+	items := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name          string
+		req           Request
+		want          []int
+		wantNextToken bool
+	}{
+		{name: "first page", req: Request{Size: 2}, want: []int{0, 1}, wantNextToken: true},
+		{name: "last page", req: Request{Size: 2, Offset: 4}, want: []int{4}},
+		{name: "default size", req: Request{}, want: items},
+		{name: "past the end", req: Request{Size: 2, Offset: 5}, want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, info, err := Slice(items, tt.req, "items")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Slice() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Slice() = %v, want %v", got, tt.want)
+				}
+			}
+			if info.Total != int32(len(items)) {
+				t.Errorf("Total = %d, want %d", info.Total, len(items))
+			}
+			if (info.NextToken != "") != tt.wantNextToken {
+				t.Errorf("NextToken = %q, wantNextToken %v", info.NextToken, tt.wantNextToken)
+			}
+		})
+	}
+}
+
+func TestSliceNextToken(t *testing.T) {
+
+	items := []int{0, 1, 2, 3, 4}
+
+	_, info, err := Slice(items, Request{Size: 3}, "items", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, err := Slice(items, Request{Size: 3, Token: info.NextToken}, "items", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != 3 {
+		t.Errorf("second page = %v, want [3 4]", got)
+	}
+
+	if _, _, err := Slice(items, Request{Token: info.NextToken}, "items", 2); err == nil {
+		t.Error("token of another list was accepted")
+	}
+}
+
+func TestOpen(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		req      Request
+		wantSize int32
+		wantErr  bool
+	}{
+		{name: "default size", req: Request{}, wantSize: DEFAULT_SIZE},
+		{name: "size over the max", req: Request{Size: MAX_SIZE + 1}, wantSize: MAX_SIZE},
+		{name: "negative size", req: Request{Size: -1}, wantErr: true},
+		{name: "negative offset", req: Request{Offset: -1}, wantErr: true},
+		{name: "bad token", req: Request{Token: "not a token"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Open(tt.req, "items")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Open() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.Size != tt.wantSize {
+				t.Errorf("Size = %d, want %d", c.Size, tt.wantSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Package preview lets internal testers read the pilot banks, cards,
+// channels and rewards along with the active ones, so new cards can be
+// checked on production data before they are launched. The interceptor
+// marks the calls of testers with With, the services read with Statuses.
+package preview
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+
+	commonM "pickrewardapi/internal/shared/common/model"
+)
+
+type key struct{}
+
+// With returns c marked as the call of a tester.
+func With(c context.Context) context.Context {
+	return context.WithValue(c, key{}, true)
+}
+
+// Enabled reports whether c is the call of a tester.
+func Enabled(c context.Context) bool {
+	enabled, _ := c.Value(key{}).(bool)
+	return enabled
+}
+
+// Statuses returns the statuses the call of c reads, active, and pilot for
+// testers.
+func Statuses(c context.Context) []commonM.Status {
+	if Enabled(c) {
+		return []commonM.Status{commonM.Active, commonM.Pilot}
+	}
+	return []commonM.Status{commonM.Active}
+}
+
+// Visible reports whether the call of c reads an item of status.
+func Visible(c context.Context, status commonM.Status) bool {
+	return slices.Contains(Statuses(c), status)
+}
+
+// IsTester reports whether token is one of tokens, compared in constant time.
+func IsTester(token string, tokens []string) bool {
+	tester := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			tester = true
+		}
+	}
+	return tester
+}
@@ -1,64 +1,69 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
 	"os"
-	"strconv"
-	"strings"
+	"os/signal"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"go.uber.org/dig"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-
+	"google.golang.org/grpc/reflection"
+
+	cache "pickrewardapi/internal/pkg/cache"
+	config "pickrewardapi/internal/pkg/config"
+	grpcweb "pickrewardapi/internal/pkg/grpcweb"
+	health "pickrewardapi/internal/pkg/health"
+	interceptor "pickrewardapi/internal/pkg/interceptor"
+	metrics "pickrewardapi/internal/pkg/metrics"
 	psql "pickrewardapi/internal/pkg/postgres"
+	tracing "pickrewardapi/internal/pkg/tracing"
 
 	bankApplication "pickrewardapi/internal/application/bank/v1"
+	bankPb "pickrewardapi/internal/application/bank/v1/proto/generated"
 	bankService "pickrewardapi/internal/domain/bank/service"
 	bankStore "pickrewardapi/internal/domain/bank/store"
 
 	cardApplication "pickrewardapi/internal/application/card/v1"
+	cardPb "pickrewardapi/internal/application/card/v1/proto/generated"
 	cardService "pickrewardapi/internal/domain/card/service"
 	cardStore "pickrewardapi/internal/domain/card/store"
 
 	channelApplication "pickrewardapi/internal/application/channel/v1"
+	channelPb "pickrewardapi/internal/application/channel/v1/proto/generated"
 	channelService "pickrewardapi/internal/domain/channel/service"
 	channelStore "pickrewardapi/internal/domain/channel/store"
-)
 
-func loadEnvFromFile(filePath string) error {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			key, value := parts[0], parts[1]
-			os.Setenv(key, value)
-		}
-	}
+	channelLabelApplication "pickrewardapi/internal/application/channel_label/v1"
+	channelLabelService "pickrewardapi/internal/domain/channel_label/service"
+	channelLabelStore "pickrewardapi/internal/domain/channel_label/store"
 
-	if err := scanner.Err(); err != nil {
-		return err
-	}
+	cardRewardApplication "pickrewardapi/internal/application/card_reward/v1"
+	cardRewardService "pickrewardapi/internal/domain/card_reward/service"
+	cardRewardStore "pickrewardapi/internal/domain/card_reward/store"
 
-	return nil
-}
+	adminApplication "pickrewardapi/internal/application/admin/v1"
 
-func initLogger() {
+	gatewayApplication "pickrewardapi/internal/application/gateway/v1"
 
-	environment := os.Getenv("ENV")
+	evaluationApplication "pickrewardapi/internal/application/evaluation/v1"
+	evaluationService "pickrewardapi/internal/domain/evaluation/service"
 
-	if environment == "prod" {
+	catalogDomain "pickrewardapi/internal/domain/catalog/domain"
+	catalogDTO "pickrewardapi/internal/domain/catalog/dto"
+	catalogService "pickrewardapi/internal/domain/catalog/service"
+)
+
+func initLogger(cfg *config.Config) {
+
+	if cfg.IsProd() {
 		log.SetFormatter(&log.JSONFormatter{})
 
 		f, err := os.OpenFile("log.txt", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
@@ -88,87 +93,104 @@ func initLogger() {
 
 }
 
-func findServerPort() int {
-	logPos := "[main][findServerPort]"
-	serverPort := os.Getenv("APP_SERVER_PORT")
-	if serverPort == "" {
-		log.WithFields(log.Fields{
-			"pos": logPos,
-		}).Info("Cannot find APP_SERVER_PORT")
-		panic(-1)
-	}
-
-	port, err := strconv.Atoi(serverPort)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"pos":         logPos,
-			"server.port": serverPort,
-		}).Error("Cannot parse APP_SERVER_PORT")
-		panic(-1)
-	}
-	return port
-}
+const healthCheckInterval = 10 * time.Second
 
-func buildContainer() *dig.Container {
+func buildContainer(cfg *config.Config) *dig.Container {
 	container := dig.New()
 
-	container.Provide(psql.NewPsql)
+	container.Provide(func() *config.Config { return cfg })
 
-	container.Provide(bankService.New)
-	container.Provide(bankStore.New)
+	if cfg.IsDemo() {
+		container.Provide(func() *psql.Psql { return nil })
 
-	container.Provide(cardService.New)
-	container.Provide(cardStore.New)
+		container.Provide(bankStore.NewMemory)
+		container.Provide(cardStore.NewMemory)
+		container.Provide(channelStore.NewMemory)
+		container.Provide(channelLabelStore.NewMemory)
+		container.Provide(cardRewardStore.NewMemory)
+	} else {
+		container.Provide(psql.NewPsql)
 
+		container.Provide(bankStore.New)
+		container.Provide(cardStore.New)
+		container.Provide(channelStore.New)
+		container.Provide(channelLabelStore.New)
+		container.Provide(cardRewardStore.New)
+	}
+
+	container.Provide(cache.New)
+	container.Provide(health.New)
+	container.Provide(metrics.New)
+
+	container.Provide(bankService.New)
+	container.Provide(cardService.New)
 	container.Provide(channelService.New)
-	container.Provide(channelStore.New)
+	container.Provide(channelLabelService.New)
+	container.Provide(cardRewardService.New)
+	container.Provide(evaluationService.New)
+	container.Provide(catalogService.New)
 
 	container.Provide(initGrpcServer)
+	container.Provide(gatewayApplication.NewGatewayServer)
 	return container
 }
 
-func parseUseTls() bool {
-	logPos := "[main][parseUseTls]"
+// loadDemoFixture imports the fixture of the demo mode into the in-memory
+// stores.
+func loadDemoFixture(cfg *config.Config, catalogService catalogService.CatalogService) error {
+	logPos := "[main][loadDemoFixture]"
 
-	lowerStr := strings.ToLower(os.Getenv("APP_USE_TLS"))
+	catalog, err := catalogDomain.ParseFile(cfg.App.DemoFixture, "")
+	if err != nil {
+		return err
+	}
 
-	if lowerStr == "true" {
-		return true
-	} else if lowerStr == "false" {
-		return false
-	} else {
-		log.WithFields(log.Fields{
-			"pos":     logPos,
-			"use.tls": lowerStr,
-		}).Error("Cannot parse APP_USE_TLS")
-		panic(-1)
+	result, err := catalogService.Import(context.Background(), catalog, false)
+	if err != nil {
+		return err
 	}
 
+	log.WithFields(log.Fields{
+		"pos":  logPos,
+		"file": cfg.App.DemoFixture,
+	}).Infof("Demo mode, loaded %d catalog entities into memory", result.Count(catalogDTO.Create))
+
+	return nil
+}
+
+// grpcWebServices are the services served to browsers over gRPC-Web.
+var grpcWebServices = []string{
+	bankPb.BankV1_ServiceDesc.ServiceName,
+	cardPb.CardV1_ServiceDesc.ServiceName,
+	channelPb.ChannelV1_ServiceDesc.ServiceName,
 }
 
 func initGrpcServer(
+	cfg *config.Config,
+
 	bankService bankService.BankService,
 	cardService cardService.CardService,
 	channelService channelService.ChannelService,
+	channelLabelService channelLabelService.ChannelLabelAppService,
+	cardRewardService cardRewardService.RewardAppService,
+	evaluationService evaluationService.EvaluationService,
+	checker *health.Checker,
 
 ) *grpc.Server {
 	logPos := "[main][initGrpcServer]"
 
-	useTls := parseUseTls()
-
 	var s *grpc.Server
 
-	if useTls {
+	opts := interceptor.ServerOptions(cfg.App.RPCTimeout, cfg.App.TesterTokens)
+
+	if cfg.App.UseTLS {
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Info("With TLS server")
 
 		// 加载证书和密钥
 
-		certPath := os.Getenv("APP_TLS_CERT_PATH")
-		keyPath := os.Getenv("APP_TLS_KEY_PATH")
-
-		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		cert, err := tls.LoadX509KeyPair(cfg.App.TLSCertPath, cfg.App.TLSKeyPath)
 		if err != nil {
 			log.Fatalf("Failed to load certificate: %v", err)
 			panic(-1)
@@ -176,7 +198,7 @@ func initGrpcServer(
 
 		// 创建 gRPC 服务器配置
 		serverCreds := credentials.NewServerTLSFromCert(&cert)
-		s = grpc.NewServer(grpc.Creds(serverCreds))
+		s = grpc.NewServer(append(opts, grpc.Creds(serverCreds))...)
 
 	} else {
 
@@ -184,13 +206,35 @@ func initGrpcServer(
 			"pos": logPos,
 		}).Info("Without TLS server")
 		// Create gRPC Server
-		s = grpc.NewServer()
+		s = grpc.NewServer(opts...)
 
 	}
 
 	bankApplication.NewBankServer(s, bankService)
 	cardApplication.NewCardServer(s, cardService)
 	channelApplication.NewChannelServer(s, channelService)
+	channelLabelApplication.NewChannelLabelServer(s, channelLabelService)
+	cardRewardApplication.NewCardRewardServer(s, cardRewardService)
+	evaluationApplication.NewEvaluationServer(s, evaluationService)
+
+	if cfg.App.AdminToken != "" {
+		adminApplication.NewAdminServer(s, cfg.App.AdminToken,
+			bankService, cardService, channelService, channelLabelService, cardRewardService)
+	} else {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Info("Without admin server, APP_ADMIN_TOKEN is empty")
+	}
+
+	if cfg.App.GrpcReflection {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Info("With grpc reflection")
+
+		reflection.Register(s)
+	}
+
+	checker.Register(s)
 
 	log.WithFields(log.Fields{
 		"pos": logPos,
@@ -204,26 +248,44 @@ func main() {
 
 	logPos := "[main][main]"
 
-	initLogger()
-
-	envFile := os.Getenv("ENV_FILE")
-	if envFile == "" {
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
-		}).Info("Cannot find ENV_FILE env, alternative get .env.dev")
-
-		envFile = ".env.dev"
+		}).Fatalf("config.Load failed: %s", err)
 	}
 
-	if err := loadEnvFromFile(envFile); err != nil {
+	initLogger(cfg)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Trace)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Errorf("tracing.Init failed: %s", err)
 		panic(err)
 	}
 
-	container := buildContainer()
+	container := buildContainer(cfg)
+
+	if cfg.IsDemo() {
+		if err := container.Invoke(loadDemoFixture); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Fatalf("loadDemoFixture failed: %s", err)
+		}
+	}
+
+	port := cfg.App.ServerPort
+
+	metricsPort := cfg.App.MetricsPort
+
+	httpPort := cfg.App.HTTPPort
 
-	port := findServerPort()
+	grpcWebPort := cfg.App.GrpcWebPort
 
-	if err := container.Invoke(func(s *grpc.Server) {
+	shutdownTimeout := cfg.App.ShutdownTimeout
+
+	if err := container.Invoke(func(s *grpc.Server, sql *psql.Psql, c *cache.Cache, checker *health.Checker, metricsServer *metrics.Server, gatewayServer *gatewayApplication.Server) {
 		// Create gRPC Server
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -236,16 +298,159 @@ func main() {
 			}).Fatalf("net.Listen failed: %s", err)
 		}
 
+		checkCtx, stopCheck := context.WithCancel(context.Background())
+		defer stopCheck()
+		go checker.Run(checkCtx, healthCheckInterval)
+
+		if metricsPort != 0 {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Infof("Starting metrics server port: %d", metricsPort)
+
+			go func() {
+				if err := metricsServer.ListenAndServe(fmt.Sprintf(":%d", metricsPort)); err != nil {
+					log.WithFields(log.Fields{
+						"pos": logPos,
+					}).Error("metricsServer.ListenAndServe failed: ", err)
+				}
+			}()
+		} else {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Info("Without metrics server, APP_METRICS_PORT is empty")
+		}
+
+		if httpPort != 0 {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Infof("Starting http gateway port: %d", httpPort)
+
+			go func() {
+				if err := gatewayServer.ListenAndServe(fmt.Sprintf(":%d", httpPort)); err != nil {
+					log.WithFields(log.Fields{
+						"pos": logPos,
+					}).Error("gatewayServer.ListenAndServe failed: ", err)
+				}
+			}()
+		} else {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Info("Without http gateway, APP_HTTP_PORT is empty")
+		}
+
+		var grpcWebServer *grpcweb.Server
+		if grpcWebPort != 0 {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Infof("Starting grpc-web server port: %d", grpcWebPort)
+
+			grpcWebServer = grpcweb.New(s, grpcWebServices, cfg.App.GrpcWebAllowedOrigins)
+
+			certPath, keyPath := "", ""
+			if cfg.App.UseTLS {
+				certPath, keyPath = cfg.App.TLSCertPath, cfg.App.TLSKeyPath
+			}
+
+			go func() {
+				if err := grpcWebServer.ListenAndServe(fmt.Sprintf(":%d", grpcWebPort), certPath, keyPath); err != nil {
+					log.WithFields(log.Fields{
+						"pos": logPos,
+					}).Error("grpcWebServer.ListenAndServe failed: ", err)
+				}
+			}()
+		} else {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Info("Without grpc-web server, APP_GRPC_WEB_PORT is empty")
+		}
+
 		log.WithFields(log.Fields{
 			"pos": logPos,
 		}).Infof("Starting grpc server port: %d", port)
 
-		if err := s.Serve(lis); err != nil {
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- s.Serve(lis)
+		}()
+
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		select {
+		case err := <-serveErr:
 			log.WithFields(log.Fields{
 				"pos": logPos,
 			}).Fatalf("s.Serve failed: %s ", err)
+		case sig := <-quit:
+			log.WithFields(log.Fields{
+				"pos":    logPos,
+				"signal": sig.String(),
+			}).Info("Shutting down grpc server")
+		}
+
+		// Report NOT_SERVING first so load balancers stop routing new calls.
+		checker.Shutdown()
+		stopCheck()
+
+		gatewayCtx, cancelGateway := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelGateway()
+
+		stopped := make(chan struct{})
+		go func() {
+			if err := gatewayServer.Shutdown(gatewayCtx); err != nil {
+				log.WithFields(log.Fields{
+					"pos": logPos,
+				}).Error("gatewayServer.Shutdown failed: ", err)
+			}
+			if grpcWebServer != nil {
+				if err := grpcWebServer.Shutdown(gatewayCtx); err != nil {
+					log.WithFields(log.Fields{
+						"pos": logPos,
+					}).Error("grpcWebServer.Shutdown failed: ", err)
+				}
+			}
+			s.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			log.WithFields(log.Fields{
+				"pos":              logPos,
+				"shutdown.timeout": shutdownTimeout.String(),
+			}).Warn("GracefulStop timed out, stopping grpc server")
+			s.Stop()
+		}
+
+		metricsCtx, cancelMetrics := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelMetrics()
+		if err := metricsServer.Shutdown(metricsCtx); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("metricsServer.Shutdown failed: ", err)
+		}
+
+		sql.Close()
+
+		if err := c.Close(); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("cache.Close failed: ", err)
 		}
 
+		tracingCtx, cancelTracing := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelTracing()
+		if err := shutdownTracing(tracingCtx); err != nil {
+			log.WithFields(log.Fields{
+				"pos": logPos,
+			}).Error("shutdownTracing failed: ", err)
+		}
+
+		log.WithFields(log.Fields{
+			"pos": logPos,
+		}).Info("Grpc server stopped")
+
 	}); err != nil {
 		log.WithFields(log.Fields{
 			"pos": logPos,
@@ -1,8 +0,0 @@
-
-DROP TABLE  IF EXISTS channel_label;
-CREATE TABLE channel_label
-(
-    "label" INT PRIMARY KEY (label),
-    "name" text,
-    "show" INT
-)
\ No newline at end of file